@@ -6,10 +6,12 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"strings"
 
 	"github.com/nguyenvanduocit/gitlab-mcp/tools"
+	"github.com/nguyenvanduocit/gitlab-mcp/util"
 
 	"github.com/joho/godotenv"
 	"github.com/mark3labs/mcp-go/server"
@@ -18,8 +20,11 @@ import (
 func main() {
 	envFile := flag.String("env", "", "Path to environment file (optional when environment variables are set directly)")
 	httpPort := flag.String("http_port", "", "Port for HTTP server. If not provided, will use stdio")
+	verbose := flag.Bool("verbose", false, "Append GitLab API diagnostics (endpoints, status, timing, retries) to every tool result")
 	flag.Parse()
 
+	util.SetVerbose(*verbose)
+
 	// Load environment file if specified
 	if *envFile != "" {
 		if err := godotenv.Load(*envFile); err != nil {
@@ -77,20 +82,36 @@ func main() {
 		server.WithPromptCapabilities(true),
 		server.WithResourceCapabilities(true, true),
 		server.WithRecovery(),
+		server.WithToolHandlerMiddleware(util.DiagnosticsMiddleware),
+		server.WithToolHandlerMiddleware(util.RateLimitMiddleware),
+		server.WithToolHandlerMiddleware(util.OutputLimitMiddleware),
+		server.WithToolHandlerMiddleware(util.ErrorHintMiddleware),
+		server.WithToolHandlerMiddleware(util.TokenMiddleware),
+		server.WithToolFilter(util.SharedArgumentsFilter),
 	)
 
 	tools.RegisterProjectTools(mcpServer)
 	tools.RegisterMergeRequestTools(mcpServer)
 	tools.RegisterRepositoryTools(mcpServer)
 	tools.RegisterBranchTools(mcpServer)
+	tools.RegisterTagTools(mcpServer)
+	tools.RegisterReleaseTools(mcpServer)
+	tools.RegisterWikiTools(mcpServer)
 	tools.RegisterPipelineTools(mcpServer)
 	tools.RegisterJobTools(mcpServer)
+	tools.RegisterRunnerTools(mcpServer)
 	tools.RegisterUserTools(mcpServer)
 	tools.RegisterGroupTools(mcpServer)
 	tools.RegisterVariableTools(mcpServer)
 	tools.RegisterFlowTools(mcpServer)
 	tools.RegisterDeploymentTools(mcpServer)
 	tools.RegisterSearchTools(mcpServer)
+	tools.RegisterLabelTools(mcpServer)
+	tools.RegisterIterationTools(mcpServer)
+	tools.RegisterIssueTools(mcpServer)
+	tools.RegisterTimeTrackingTools(mcpServer)
+	tools.RegisterAwardEmojiTools(mcpServer)
+	tools.RegisterResourceEventTools(mcpServer)
 
 	if *httpPort != "" {
 		fmt.Println()
@@ -117,7 +138,10 @@ func main() {
 		fmt.Println()
 		fmt.Println("🔄 Server starting...")
 		
-		httpServer := server.NewStreamableHTTPServer(mcpServer, server.WithEndpointPath("/mcp"))
+		httpServer := server.NewStreamableHTTPServer(mcpServer,
+			server.WithEndpointPath("/mcp"),
+			server.WithHTTPContextFunc(scopeFromHeaders),
+		)
 		if err := httpServer.Start(fmt.Sprintf(":%s", *httpPort)); err != nil && !isContextCanceled(err) {
 			log.Fatalf("❌ Server error: %v", err)
 		}
@@ -128,6 +152,18 @@ func main() {
 	}
 }
 
+// scopeFromHeaders lets HTTP clients authenticate as themselves with an X-Gitlab-Token
+// header and target another instance with an X-Gitlab-Url header
+func scopeFromHeaders(ctx context.Context, r *http.Request) context.Context {
+	if token := r.Header.Get("X-Gitlab-Token"); token != "" {
+		ctx = util.WithToken(ctx, token)
+	}
+	if baseURL := r.Header.Get("X-Gitlab-Url"); baseURL != "" {
+		ctx = util.WithBaseURL(ctx, baseURL)
+	}
+	return ctx
+}
+
 // IsContextCanceled checks if the error is related to context cancellation
 func isContextCanceled(err error) bool {
 	if err == nil {
@@ -0,0 +1,173 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/gitlab-mcp/util"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Consolidated award emoji arguments with action-based routing
+type AwardEmojiArgs struct {
+	Action       string `json:"action" validate:"required,oneof=list create delete"`
+	ProjectPath  string `json:"project_path" validate:"required,min=1,max=255"`
+	ResourceType string `json:"resource_type" validate:"required,oneof=issue merge_request"`
+	IID          int    `json:"iid" validate:"required,min=1"`
+	Name         string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	AwardID      int    `json:"award_id,omitempty" validate:"omitempty,min=1"`
+	Confirmed    bool   `json:"confirmed,omitempty"`
+}
+
+func RegisterAwardEmojiTools(s *server.MCPServer) {
+	awardEmojiTool := mcp.NewTool("manage_award_emoji",
+		mcp.WithDescription("List, add or remove emoji reactions on issues and merge requests, e.g. for quick 👍/👎 voting"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: list, create, delete")),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
+		mcp.WithString("resource_type", mcp.Required(), mcp.Description("Whether iid refers to an issue or a merge request"), mcp.Enum("issue", "merge_request")),
+		mcp.WithNumber("iid", mcp.Required(), mcp.Description("Issue or merge request IID")),
+		mcp.WithString("name", mcp.Description("Emoji name without colons, e.g. 'thumbsup', 'thumbsdown', 'tada' (required for create; for delete, removes your own reaction with this name)")),
+		mcp.WithNumber("award_id", mcp.Description("ID of the reaction to remove, as shown by list (delete action)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for create and delete actions")),
+	)
+
+	s.AddTool(awardEmojiTool, mcp.NewTypedToolHandler(awardEmojiHandler))
+}
+
+func awardEmojiHandler(ctx context.Context, request mcp.CallToolRequest, args AwardEmojiArgs) (*mcp.CallToolResult, error) {
+	args.Name = strings.Trim(args.Name, ":")
+	resource := resourceName(args.ResourceType, args.IID)
+
+	switch args.Action {
+	case "list":
+		awards, err := listAwardEmoji(ctx, args)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to list reactions on %s: %v", resource, err)), nil
+		}
+		return mcp.NewToolResultText(formatAwardEmoji(resource, awards)), nil
+	case "create":
+		if !args.Confirmed {
+			return mcp.NewToolResultError(fmt.Sprintf("This operation requires confirmation. Please set 'confirmed: true' to proceed with reacting to %s.", resource)), nil
+		}
+		if args.Name == "" {
+			return mcp.NewToolResultError("name is required for create action"), nil
+		}
+		return createAwardEmoji(ctx, args, resource)
+	case "delete":
+		if !args.Confirmed {
+			return mcp.NewToolResultError(fmt.Sprintf("This operation requires confirmation. Please set 'confirmed: true' to proceed with removing the reaction from %s.", resource)), nil
+		}
+		if args.AwardID == 0 && args.Name == "" {
+			return mcp.NewToolResultError("award_id or name is required for delete action"), nil
+		}
+		return deleteAwardEmoji(ctx, args, resource)
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("invalid action: %s. Valid actions are: list, create, delete", args.Action)), nil
+	}
+}
+
+func listAwardEmoji(ctx context.Context, args AwardEmojiArgs) ([]*gitlab.AwardEmoji, error) {
+	opt := &gitlab.ListOptions{PerPage: util.DefaultPerPage(100)}
+
+	client := util.GitlabClientFromContext(ctx)
+	return util.AllPages(opt, func() ([]*gitlab.AwardEmoji, *gitlab.Response, error) {
+		if args.ResourceType == "merge_request" {
+			return client.AwardEmoji.ListMergeRequestAwardEmoji(args.ProjectPath, args.IID, (*gitlab.ListAwardEmojiOptions)(opt), gitlab.WithContext(ctx))
+		}
+		return client.AwardEmoji.ListIssueAwardEmoji(args.ProjectPath, args.IID, (*gitlab.ListAwardEmojiOptions)(opt), gitlab.WithContext(ctx))
+	})
+}
+
+func createAwardEmoji(ctx context.Context, args AwardEmojiArgs, resource string) (*mcp.CallToolResult, error) {
+	opt := &gitlab.CreateAwardEmojiOptions{
+		Name: args.Name,
+	}
+
+	client := util.GitlabClientFromContext(ctx)
+	var award *gitlab.AwardEmoji
+	var err error
+	if args.ResourceType == "merge_request" {
+		award, _, err = client.AwardEmoji.CreateMergeRequestAwardEmoji(args.ProjectPath, args.IID, opt, gitlab.WithContext(ctx))
+	} else {
+		award, _, err = client.AwardEmoji.CreateIssueAwardEmoji(args.ProjectPath, args.IID, opt, gitlab.WithContext(ctx))
+	}
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to react to %s: %v", resource, err)), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Added :%s: to %s (award ID: %d)\n", award.Name, resource, award.ID)), nil
+}
+
+func deleteAwardEmoji(ctx context.Context, args AwardEmojiArgs, resource string) (*mcp.CallToolResult, error) {
+	client := util.GitlabClientFromContext(ctx)
+
+	awardID := args.AwardID
+	if awardID == 0 {
+		// Without an ID, remove the caller's own reaction with the given name
+		user, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to get current user: %v", err)), nil
+		}
+		awards, err := listAwardEmoji(ctx, args)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to list reactions on %s: %v", resource, err)), nil
+		}
+		for _, award := range awards {
+			if award.Name == args.Name && award.User.ID == user.ID {
+				awardID = award.ID
+				break
+			}
+		}
+		if awardID == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("you have no :%s: reaction on %s", args.Name, resource)), nil
+		}
+	}
+
+	var err error
+	if args.ResourceType == "merge_request" {
+		_, err = client.AwardEmoji.DeleteMergeRequestAwardEmoji(args.ProjectPath, args.IID, awardID, gitlab.WithContext(ctx))
+	} else {
+		_, err = client.AwardEmoji.DeleteIssueAwardEmoji(args.ProjectPath, args.IID, awardID, gitlab.WithContext(ctx))
+	}
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to remove reaction from %s: %v", resource, err)), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Removed reaction %d from %s\n", awardID, resource)), nil
+}
+
+func formatAwardEmoji(resource string, awards []*gitlab.AwardEmoji) string {
+	if len(awards) == 0 {
+		return fmt.Sprintf("No reactions on %s\n", resource)
+	}
+
+	// Group by emoji so votes read as counts
+	byName := make(map[string][]*gitlab.AwardEmoji)
+	for _, award := range awards {
+		byName[award.Name] = append(byName[award.Name], award)
+	}
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if len(byName[names[i]]) != len(byName[names[j]]) {
+			return len(byName[names[i]]) > len(byName[names[j]])
+		}
+		return names[i] < names[j]
+	})
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Reactions on %s:\n\n", resource))
+	for _, name := range names {
+		result.WriteString(fmt.Sprintf(":%s: %d\n", name, len(byName[name])))
+		for _, award := range byName[name] {
+			result.WriteString(fmt.Sprintf("  - %s (award ID: %d)\n", award.User.Username, award.ID))
+		}
+	}
+	return result.String()
+}
@@ -0,0 +1,42 @@
+package tools
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestDeleteAwardEmojiFindsOwnReactionOnLaterPages(t *testing.T) {
+	deleted := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"id": 5, "username": "me"})
+	})
+	mux.HandleFunc("GET /api/v4/projects/group%2Fproject/issues/3/award_emoji", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, []map[string]any{{"id": 22, "name": "thumbsup", "user": map[string]any{"id": 5, "username": "me"}}})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{{"id": 21, "name": "thumbsup", "user": map[string]any{"id": 6, "username": "other"}}})
+	})
+	mux.HandleFunc("DELETE /api/v4/projects/group%2Fproject/issues/3/award_emoji/22", func(w http.ResponseWriter, r *http.Request) {
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	result, err := awardEmojiHandler(newTestContext(t, mux), mcp.CallToolRequest{}, AwardEmojiArgs{
+		Action:       "delete",
+		ProjectPath:  "group/project",
+		ResourceType: "issue",
+		IID:          3,
+		Name:         "thumbsup",
+		Confirmed:    true,
+	})
+	resultText(t, result, err)
+
+	if !deleted {
+		t.Error("own reaction on the second page was not deleted")
+	}
+}
@@ -22,6 +22,25 @@ type BranchProtectionArgs struct {
 	ProtectionOptions ProtectionOptions `json:"protection_options"`
 }
 
+// Branch Management
+type BranchManagementArgs struct {
+	Action      string `json:"action" validate:"required,oneof=list get create delete delete_merged"`
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=255"`
+	BranchName  string `json:"branch_name" validate:"omitempty,min=1,max=255"`
+	Ref         string `json:"ref,omitempty" validate:"omitempty,min=1,max=255"`
+	Confirmed   bool   `json:"confirmed,omitempty"`
+
+	// List options
+	ListOptions struct {
+		Search string `json:"search,omitempty" validate:"omitempty,min=1,max=200"`
+	} `json:"list_options"`
+
+	// Delete options
+	DeleteOptions struct {
+		RequireMerged bool `json:"require_merged,omitempty"`
+	} `json:"delete_options"`
+}
+
 func RegisterBranchTools(s *server.MCPServer) {
 	// Branch Protection Management Tool
 	branchProtectionTool := mcp.NewTool("manage_branch_protection",
@@ -74,8 +93,239 @@ func RegisterBranchTools(s *server.MCPServer) {
 		),
 	)
 
+	// Branch Management Tool
+	branchManagementTool := mcp.NewTool("manage_branches",
+		mcp.WithDescription("Manage branches for GitLab projects without gitflow naming: list, get, create, delete, delete_merged"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: list, get, create, delete, delete_merged")),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
+		mcp.WithString("branch_name", mcp.Description("Branch name (1-255 characters, required for: get, create, delete)")),
+		mcp.WithString("ref", mcp.Description("Branch, tag, or commit SHA to create the branch from (required for: create)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for delete and delete_merged actions")),
+
+		// List options
+		mcp.WithObject("list_options",
+			mcp.Description("Options for list action"),
+			mcp.Properties(map[string]any{
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Only return branches whose name contains this string (1-200 characters)",
+					"minLength":   1,
+					"maxLength":   200,
+				},
+			}),
+		),
+
+		// Delete options
+		mcp.WithObject("delete_options",
+			mcp.Description("Options for delete action"),
+			mcp.Properties(map[string]any{
+				"require_merged": map[string]any{
+					"type":        "boolean",
+					"description": "Refuse to delete the branch unless all its commits are merged into the default branch",
+					"default":     false,
+				},
+			}),
+		),
+	)
+
 	// Register tool
 	s.AddTool(branchProtectionTool, mcp.NewTypedToolHandler(branchProtectionHandler))
+	s.AddTool(branchManagementTool, mcp.NewTypedToolHandler(branchManagementHandler))
+}
+
+func branchManagementHandler(ctx context.Context, request mcp.CallToolRequest, args BranchManagementArgs) (*mcp.CallToolResult, error) {
+	switch args.Action {
+	case "list":
+		return listBranches(ctx, args.ProjectPath, args.ListOptions.Search)
+
+	case "get":
+		if args.BranchName == "" {
+			return mcp.NewToolResultError("branch_name is required for get action"), nil
+		}
+		return getBranch(ctx, args.ProjectPath, args.BranchName)
+
+	case "create":
+		if args.BranchName == "" {
+			return mcp.NewToolResultError("branch_name is required for create action"), nil
+		}
+		if args.Ref == "" {
+			return mcp.NewToolResultError("ref is required for create action"), nil
+		}
+		return createBranch(ctx, args.ProjectPath, args.BranchName, args.Ref)
+
+	case "delete":
+		if args.BranchName == "" {
+			return mcp.NewToolResultError("branch_name is required for delete action"), nil
+		}
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with deleting the branch."), nil
+		}
+		return deleteBranch(ctx, args.ProjectPath, args.BranchName, args.DeleteOptions.RequireMerged)
+
+	case "delete_merged":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with deleting all merged branches."), nil
+		}
+		return deleteMergedBranches(ctx, args.ProjectPath)
+
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("invalid action: %s. Valid actions are: list, get, create, delete, delete_merged", args.Action)), nil
+	}
+}
+
+// Upper bound on branches gathered across pages for one list
+const maxListedBranches = 500
+
+func listBranches(ctx context.Context, projectPath, search string) (*mcp.CallToolResult, error) {
+	opt := &gitlab.ListBranchesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: util.DefaultPerPage(100)},
+	}
+	if search != "" {
+		opt.Search = gitlab.Ptr(search)
+	}
+
+	branches, truncated, err := util.CollectPages(&opt.ListOptions, maxListedBranches, func() ([]*gitlab.Branch, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).Branches.ListBranches(projectPath, opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list branches: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Branches for project %s:\n\n", projectPath))
+
+	if len(branches) == 0 {
+		result.WriteString("No branches found.\n")
+		return mcp.NewToolResultText(result.String()), nil
+	}
+
+	for _, branch := range branches {
+		result.WriteString(fmt.Sprintf("- %s", branch.Name))
+		var flags []string
+		if branch.Default {
+			flags = append(flags, "default")
+		}
+		if branch.Protected {
+			flags = append(flags, "protected")
+		}
+		if branch.Merged {
+			flags = append(flags, "merged")
+		}
+		if len(flags) > 0 {
+			result.WriteString(fmt.Sprintf(" [%s]", strings.Join(flags, ", ")))
+		}
+		if branch.Commit != nil {
+			result.WriteString(fmt.Sprintf(" %s %s", branch.Commit.ShortID, branch.Commit.Title))
+		}
+		result.WriteString("\n")
+	}
+	if truncated {
+		result.WriteString(fmt.Sprintf("\nShowing the first %d branches. Use search to narrow the list.\n", maxListedBranches))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func getBranch(ctx context.Context, projectPath, branchName string) (*mcp.CallToolResult, error) {
+	branch, _, err := util.GitlabClientFromContext(ctx).Branches.GetBranch(projectPath, branchName, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get branch: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(formatBranch(branch)), nil
+}
+
+func createBranch(ctx context.Context, projectPath, branchName, ref string) (*mcp.CallToolResult, error) {
+	branch, _, err := util.GitlabClientFromContext(ctx).Branches.CreateBranch(projectPath, &gitlab.CreateBranchOptions{
+		Branch: gitlab.Ptr(branchName),
+		Ref:    gitlab.Ptr(ref),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to create branch: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Successfully created branch '%s' from %s\n\n%s", branchName, ref, formatBranch(branch))), nil
+}
+
+func deleteMergedBranches(ctx context.Context, projectPath string) (*mcp.CallToolResult, error) {
+	_, err := util.GitlabClientFromContext(ctx).Branches.DeleteMergedBranches(projectPath, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to delete merged branches: %v", err)), nil
+	}
+
+	// GitLab deletes merged branches asynchronously and skips protected ones
+	return mcp.NewToolResultText(fmt.Sprintf("Scheduled deletion of all branches merged into the default branch in project %s (protected branches are kept)\n", projectPath)), nil
+}
+
+func formatBranch(branch *gitlab.Branch) string {
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Branch: %s\n", branch.Name))
+	result.WriteString(fmt.Sprintf("Default: %t\n", branch.Default))
+	result.WriteString(fmt.Sprintf("Protected: %t\n", branch.Protected))
+	result.WriteString(fmt.Sprintf("Merged: %t\n", branch.Merged))
+	result.WriteString(fmt.Sprintf("Developers Can Push: %t\n", branch.DevelopersCanPush))
+	result.WriteString(fmt.Sprintf("Developers Can Merge: %t\n", branch.DevelopersCanMerge))
+	if branch.WebURL != "" {
+		result.WriteString(fmt.Sprintf("URL: %s\n", branch.WebURL))
+	}
+
+	if branch.Commit != nil {
+		result.WriteString("\nLatest Commit:\n")
+		result.WriteString(fmt.Sprintf("SHA: %s\n", branch.Commit.ID))
+		result.WriteString(fmt.Sprintf("Title: %s\n", branch.Commit.Title))
+		result.WriteString(fmt.Sprintf("Author: %s\n", branch.Commit.AuthorName))
+		if branch.Commit.CommittedDate != nil {
+			result.WriteString(fmt.Sprintf("Committed: %s\n", branch.Commit.CommittedDate.Format("2006-01-02 15:04:05")))
+		}
+	}
+
+	return result.String()
+}
+
+func deleteBranch(ctx context.Context, projectPath, branchName string, requireMerged bool) (*mcp.CallToolResult, error) {
+	if requireMerged {
+		merged, err := isBranchMerged(ctx, projectPath, branchName)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to check merge status: %v", err)), nil
+		}
+		if !merged {
+			return mcp.NewToolResultError(fmt.Sprintf("branch '%s' has commits that are not merged into the default branch; refusing to delete it", branchName)), nil
+		}
+	}
+
+	_, err := util.GitlabClientFromContext(ctx).Branches.DeleteBranch(projectPath, branchName, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to delete branch: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Successfully deleted branch '%s' in project %s\n", branchName, projectPath)), nil
+}
+
+// Helper function to check whether all commits of a branch are in the default branch
+func isBranchMerged(ctx context.Context, projectPath, branchName string) (bool, error) {
+	branch, _, err := util.GitlabClientFromContext(ctx).Branches.GetBranch(projectPath, branchName, gitlab.WithContext(ctx))
+	if err != nil {
+		return false, err
+	}
+	if branch.Merged {
+		return true, nil
+	}
+
+	project, _, err := util.GitlabClientFromContext(ctx).Projects.GetProject(projectPath, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return false, err
+	}
+
+	// No commits ahead of the default branch also counts as merged
+	compare, _, err := util.GitlabClientFromContext(ctx).Repositories.Compare(projectPath, &gitlab.CompareOptions{
+		From: gitlab.Ptr(project.DefaultBranch),
+		To:   gitlab.Ptr(branchName),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return false, err
+	}
+
+	return len(compare.Commits) == 0, nil
 }
 
 func branchProtectionHandler(ctx context.Context, request mcp.CallToolRequest, args BranchProtectionArgs) (*mcp.CallToolResult, error) {
@@ -186,7 +436,7 @@ func protectBranch(ctx context.Context, projectPath, branchName string, options
 		opt.CodeOwnerApprovalRequired = gitlab.Ptr(true)
 	}
 
-	branch, _, err := util.GitlabClient().ProtectedBranches.ProtectRepositoryBranches(projectPath, opt)
+	branch, _, err := util.GitlabClientFromContext(ctx).ProtectedBranches.ProtectRepositoryBranches(projectPath, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to protect branch: %v", err)), nil
 	}
@@ -220,7 +470,7 @@ func protectBranch(ctx context.Context, projectPath, branchName string, options
 }
 
 func unprotectBranch(ctx context.Context, projectPath, branchName string) (*mcp.CallToolResult, error) {
-	_, err := util.GitlabClient().ProtectedBranches.UnprotectRepositoryBranches(projectPath, branchName)
+	_, err := util.GitlabClientFromContext(ctx).ProtectedBranches.UnprotectRepositoryBranches(projectPath, branchName, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to unprotect branch: %v", err)), nil
 	}
@@ -233,7 +483,7 @@ func unprotectBranch(ctx context.Context, projectPath, branchName string) (*mcp.
 }
 
 func listProtectedBranches(ctx context.Context, projectPath string) (*mcp.CallToolResult, error) {
-	branches, _, err := util.GitlabClient().ProtectedBranches.ListProtectedBranches(projectPath, nil)
+	branches, _, err := util.GitlabClientFromContext(ctx).ProtectedBranches.ListProtectedBranches(projectPath, nil, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to list protected branches: %v", err)), nil
 	}
@@ -262,7 +512,7 @@ func listProtectedBranches(ctx context.Context, projectPath string) (*mcp.CallTo
 }
 
 func getBranchProtection(ctx context.Context, projectPath, branchName string) (*mcp.CallToolResult, error) {
-	branch, _, err := util.GitlabClient().ProtectedBranches.GetProtectedBranch(projectPath, branchName)
+	branch, _, err := util.GitlabClientFromContext(ctx).ProtectedBranches.GetProtectedBranch(projectPath, branchName, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get branch protection: %v", err)), nil
 	}
@@ -0,0 +1,31 @@
+package tools
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestListBranchesReadsEveryPage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/repository/branches", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, []map[string]any{{"name": "feature"}})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{{"name": "main", "default": true}})
+	})
+
+	result, err := listBranches(newTestContext(t, mux), "group/project", "")
+	text := resultText(t, result, err)
+
+	for _, want := range []string{"- main [default]", "- feature"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("result missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "Showing the first") {
+		t.Errorf("result should not be marked truncated:\n%s", text)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,6 +16,9 @@ import (
 // Complex typed structures for deploy tokens
 type ListAllDeployTokensArgs struct {
 	RandomString string `json:"random_string" validate:"required"` // Dummy parameter for no-parameter tools
+	Search       string `json:"search,omitempty"`                  // Filter by name substring
+	ActiveOnly   bool   `json:"active_only,omitempty"`             // Hide revoked and expired tokens
+	WarnDays     int    `json:"warn_days,omitempty"`               // Flag tokens expiring within this many days
 }
 
 // Nested structures for complex typed tools
@@ -35,14 +39,26 @@ type DeployTokenIdentifier struct {
 	ID string `json:"id" validate:"required,numeric"` // Deploy token ID
 }
 
+type DeployTokenListOptions struct {
+	Search     string `json:"search,omitempty"`      // Filter by name substring
+	ActiveOnly bool   `json:"active_only,omitempty"` // Hide revoked and expired tokens
+	WarnDays   int    `json:"warn_days,omitempty"`   // Flag tokens expiring within this many days
+}
+
 type ManageDeployTokensArgs struct {
 	Action     string                     `json:"action" validate:"required,oneof=list get create delete"` // Action to perform
 	Scope      DeployTokenScope          `json:"scope"`                                                    // Scope configuration
 	TokenID    *DeployTokenIdentifier    `json:"token_id,omitempty"`                                      // For get/delete actions
 	CreateOpts *DeployTokenCreateOptions `json:"create_options,omitempty"`                               // For create action
+	ListOpts   *DeployTokenListOptions   `json:"list_options,omitempty"`                                 // For list action
 	Confirmed  bool                      `json:"confirmed,omitempty"`                                     // Confirmation for destructive operations
 }
 
+type DeployStatusArgs struct {
+	ProjectPath    string `json:"project_path" validate:"required,min=1,max=255"` // Project to report on
+	IncludeStopped bool   `json:"include_stopped,omitempty"`                      // Also show stopped environments
+}
+
 func RegisterDeploymentTools(s *server.MCPServer) {
 	// List all deploy tokens (admin only)
 	listAllDeployTokensTool := mcp.NewTool("list_all_deploy_tokens",
@@ -50,6 +66,12 @@ func RegisterDeploymentTools(s *server.MCPServer) {
 		mcp.WithString("random_string", 
 			mcp.Required(), 
 			mcp.Description("Dummy parameter for no-parameter tools")),
+		mcp.WithString("search",
+			mcp.Description("Only show tokens whose name contains this text (case-insensitive)")),
+		mcp.WithBoolean("active_only",
+			mcp.Description("Hide revoked and expired tokens")),
+		mcp.WithNumber("warn_days",
+			mcp.Description("Flag tokens expiring within this many days (default: 30)")),
 	)
 
 	// Complex typed deploy tokens management tool
@@ -91,6 +113,23 @@ func RegisterDeploymentTools(s *server.MCPServer) {
 					"pattern":     "^[0-9]+$",
 				},
 			})),
+		mcp.WithObject("list_options",
+			mcp.Description("Filtering options for the list action"),
+			mcp.Properties(map[string]any{
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Only show tokens whose name contains this text (case-insensitive)",
+				},
+				"active_only": map[string]any{
+					"type":        "boolean",
+					"description": "Hide revoked and expired tokens",
+				},
+				"warn_days": map[string]any{
+					"type":        "number",
+					"description": "Flag tokens expiring within this many days (default: 30)",
+					"minimum":     1,
+				},
+			})),
 		mcp.WithObject("create_options",
 			mcp.Description("Options for creating a new deploy token (required for create action)"),
 			mcp.Properties(map[string]any{
@@ -129,34 +168,98 @@ func RegisterDeploymentTools(s *server.MCPServer) {
 			})),
 	)
 
+	// Consolidated deployment view across environments
+	deployStatusTool := mcp.NewTool("deploy_status",
+		mcp.WithDescription("Show what is deployed where: each environment's latest deployment, its ref and SHA, and the pipeline status"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
+		mcp.WithBoolean("include_stopped", mcp.Description("Include stopped environments (default: only available ones)")),
+	)
+
 	// Register handlers
 	s.AddTool(listAllDeployTokensTool, mcp.NewTypedToolHandler(listAllDeployTokensHandler))
 	s.AddTool(manageDeployTokensTool, mcp.NewTypedToolHandler(manageDeployTokensHandler))
+	s.AddTool(deployStatusTool, mcp.NewTypedToolHandler(deployStatusHandler))
 }
 
 // Handlers
 
 func listAllDeployTokensHandler(ctx context.Context, request mcp.CallToolRequest, args ListAllDeployTokensArgs) (*mcp.CallToolResult, error) {
-	tokens, _, err := util.GitlabClient().DeployTokens.ListAllDeployTokens()
+	tokens, _, err := util.GitlabClientFromContext(ctx).DeployTokens.ListAllDeployTokens(gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to list deploy tokens: %v", err)), nil
 	}
 
+	tokens = filterDeployTokens(tokens, args.Search, args.ActiveOnly)
+
 	var result string
 	result += fmt.Sprintf("Found %d deploy tokens:\n\n", len(tokens))
-	
+	result += formatDeployTokenList(tokens, args.WarnDays)
+
+	return mcp.NewToolResultText(result), nil
+}
+
+// filterDeployTokens applies client-side name and status filtering, since the
+// deploy token endpoints offer no search parameters.
+func filterDeployTokens(tokens []*gitlab.DeployToken, search string, activeOnly bool) []*gitlab.DeployToken {
+	if search == "" && !activeOnly {
+		return tokens
+	}
+
+	search = strings.ToLower(search)
+	var filtered []*gitlab.DeployToken
+	for _, token := range tokens {
+		if search != "" && !strings.Contains(strings.ToLower(token.Name), search) {
+			continue
+		}
+		if activeOnly && (token.Revoked || token.Expired) {
+			continue
+		}
+		filtered = append(filtered, token)
+	}
+	return filtered
+}
+
+const defaultTokenExpiryWarnDays = 30
+
+// expiryWarning returns a note for tokens that are still valid but expire
+// within warnDays, so they can be rotated before CI starts failing.
+func expiryWarning(expiresAt *time.Time, warnDays int) string {
+	if expiresAt == nil {
+		return ""
+	}
+	if warnDays <= 0 {
+		warnDays = defaultTokenExpiryWarnDays
+	}
+
+	remaining := time.Until(*expiresAt)
+	if remaining <= 0 || remaining > time.Duration(warnDays)*24*time.Hour {
+		return ""
+	}
+
+	days := int(remaining.Hours() / 24)
+	if days == 0 {
+		return " ⚠️ expires soon (less than a day left)"
+	}
+	return fmt.Sprintf(" ⚠️ expires soon (%d days left)", days)
+}
+
+func formatDeployTokenList(tokens []*gitlab.DeployToken, warnDays int) string {
+	var result string
 	for _, token := range tokens {
 		result += fmt.Sprintf("ID: %d\nName: %s\nUsername: %s\nRevoked: %t\nExpired: %t\nScopes: %v\n",
 			token.ID, token.Name, token.Username, token.Revoked, token.Expired, token.Scopes)
-		
+
 		if token.ExpiresAt != nil {
-			result += fmt.Sprintf("Expires: %s\n", token.ExpiresAt.Format("2006-01-02 15:04:05"))
+			warning := ""
+			if !token.Revoked && !token.Expired {
+				warning = expiryWarning(token.ExpiresAt, warnDays)
+			}
+			result += fmt.Sprintf("Expires: %s%s\n", token.ExpiresAt.Format("2006-01-02 15:04:05"), warning)
 		}
-		
+
 		result += "\n"
 	}
-
-	return mcp.NewToolResultText(result), nil
+	return result
 }
 
 func manageDeployTokensHandler(ctx context.Context, request mcp.CallToolRequest, args ManageDeployTokensArgs) (*mcp.CallToolResult, error) {
@@ -192,69 +295,64 @@ func manageDeployTokensHandler(ctx context.Context, request mcp.CallToolRequest,
 	// Route to appropriate handler based on action
 	switch args.Action {
 	case "list":
-		return handleListDeployTokens(args)
+		return handleListDeployTokens(ctx, args)
 	case "get":
-		return handleGetDeployToken(args)
+		return handleGetDeployToken(ctx, args)
 	case "create":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating a deploy token."), nil
 		}
-		return handleCreateDeployToken(args)
+		return handleCreateDeployToken(ctx, args)
 	case "delete":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with deleting a deploy token."), nil
 		}
-		return handleDeleteDeployToken(args)
+		return handleDeleteDeployToken(ctx, args)
 	default:
 		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s", args.Action)), nil
 	}
 }
 
-func handleListDeployTokens(args ManageDeployTokensArgs) (*mcp.CallToolResult, error) {
+func handleListDeployTokens(ctx context.Context, args ManageDeployTokensArgs) (*mcp.CallToolResult, error) {
+	var search string
+	var activeOnly bool
+	var warnDays int
+	if args.ListOpts != nil {
+		search = args.ListOpts.Search
+		activeOnly = args.ListOpts.ActiveOnly
+		warnDays = args.ListOpts.WarnDays
+	}
+
 	var result string
 	
 	if args.Scope.Type == "project" {
-		tokens, _, err := util.GitlabClient().DeployTokens.ListProjectDeployTokens(args.Scope.ProjectPath, nil)
+		tokens, _, err := util.GitlabClientFromContext(ctx).DeployTokens.ListProjectDeployTokens(args.Scope.ProjectPath, nil, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to list project deploy tokens: %v", err)), nil
 		}
-		
+
+		tokens = filterDeployTokens(tokens, search, activeOnly)
+
 		result += fmt.Sprintf("Deploy tokens for project '%s' (%d tokens):\n\n", args.Scope.ProjectPath, len(tokens))
 		
-		for _, token := range tokens {
-			result += fmt.Sprintf("ID: %d\nName: %s\nUsername: %s\nRevoked: %t\nExpired: %t\nScopes: %v\n",
-				token.ID, token.Name, token.Username, token.Revoked, token.Expired, token.Scopes)
-			
-			if token.ExpiresAt != nil {
-				result += fmt.Sprintf("Expires: %s\n", token.ExpiresAt.Format("2006-01-02 15:04:05"))
-			}
-			
-			result += "\n"
-		}
+		result += formatDeployTokenList(tokens, warnDays)
 	} else { // group
-		tokens, _, err := util.GitlabClient().DeployTokens.ListGroupDeployTokens(args.Scope.GroupID, nil)
+		tokens, _, err := util.GitlabClientFromContext(ctx).DeployTokens.ListGroupDeployTokens(args.Scope.GroupID, nil, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to list group deploy tokens: %v", err)), nil
 		}
-		
+
+		tokens = filterDeployTokens(tokens, search, activeOnly)
+
 		result += fmt.Sprintf("Deploy tokens for group '%s' (%d tokens):\n\n", args.Scope.GroupID, len(tokens))
 		
-		for _, token := range tokens {
-			result += fmt.Sprintf("ID: %d\nName: %s\nUsername: %s\nRevoked: %t\nExpired: %t\nScopes: %v\n",
-				token.ID, token.Name, token.Username, token.Revoked, token.Expired, token.Scopes)
-			
-			if token.ExpiresAt != nil {
-				result += fmt.Sprintf("Expires: %s\n", token.ExpiresAt.Format("2006-01-02 15:04:05"))
-			}
-			
-			result += "\n"
-		}
+		result += formatDeployTokenList(tokens, warnDays)
 	}
 
 	return mcp.NewToolResultText(result), nil
 }
 
-func handleGetDeployToken(args ManageDeployTokensArgs) (*mcp.CallToolResult, error) {
+func handleGetDeployToken(ctx context.Context, args ManageDeployTokensArgs) (*mcp.CallToolResult, error) {
 	deployTokenID, err := strconv.Atoi(args.TokenID.ID)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("invalid deploy token ID: %v", err)), nil
@@ -263,7 +361,7 @@ func handleGetDeployToken(args ManageDeployTokensArgs) (*mcp.CallToolResult, err
 	var result string
 	
 	if args.Scope.Type == "project" {
-		token, _, err := util.GitlabClient().DeployTokens.GetProjectDeployToken(args.Scope.ProjectPath, deployTokenID)
+		token, _, err := util.GitlabClientFromContext(ctx).DeployTokens.GetProjectDeployToken(args.Scope.ProjectPath, deployTokenID, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to get project deploy token: %v", err)), nil
 		}
@@ -275,7 +373,7 @@ func handleGetDeployToken(args ManageDeployTokensArgs) (*mcp.CallToolResult, err
 			result += fmt.Sprintf("Expires: %s\n", token.ExpiresAt.Format("2006-01-02 15:04:05"))
 		}
 	} else { // group
-		token, _, err := util.GitlabClient().DeployTokens.GetGroupDeployToken(args.Scope.GroupID, deployTokenID)
+		token, _, err := util.GitlabClientFromContext(ctx).DeployTokens.GetGroupDeployToken(args.Scope.GroupID, deployTokenID, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to get group deploy token: %v", err)), nil
 		}
@@ -291,7 +389,7 @@ func handleGetDeployToken(args ManageDeployTokensArgs) (*mcp.CallToolResult, err
 	return mcp.NewToolResultText(result), nil
 }
 
-func handleCreateDeployToken(args ManageDeployTokensArgs) (*mcp.CallToolResult, error) {
+func handleCreateDeployToken(ctx context.Context, args ManageDeployTokensArgs) (*mcp.CallToolResult, error) {
 	var result string
 	
 	if args.Scope.Type == "project" {
@@ -312,7 +410,7 @@ func handleCreateDeployToken(args ManageDeployTokensArgs) (*mcp.CallToolResult,
 			opt.Username = gitlab.Ptr(args.CreateOpts.Username)
 		}
 
-		token, _, err := util.GitlabClient().DeployTokens.CreateProjectDeployToken(args.Scope.ProjectPath, opt)
+		token, _, err := util.GitlabClientFromContext(ctx).DeployTokens.CreateProjectDeployToken(args.Scope.ProjectPath, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to create project deploy token: %v", err)), nil
 		}
@@ -341,7 +439,7 @@ func handleCreateDeployToken(args ManageDeployTokensArgs) (*mcp.CallToolResult,
 			opt.Username = gitlab.Ptr(args.CreateOpts.Username)
 		}
 
-		token, _, err := util.GitlabClient().DeployTokens.CreateGroupDeployToken(args.Scope.GroupID, opt)
+		token, _, err := util.GitlabClientFromContext(ctx).DeployTokens.CreateGroupDeployToken(args.Scope.GroupID, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to create group deploy token: %v", err)), nil
 		}
@@ -358,7 +456,7 @@ func handleCreateDeployToken(args ManageDeployTokensArgs) (*mcp.CallToolResult,
 	return mcp.NewToolResultText(result), nil
 }
 
-func handleDeleteDeployToken(args ManageDeployTokensArgs) (*mcp.CallToolResult, error) {
+func handleDeleteDeployToken(ctx context.Context, args ManageDeployTokensArgs) (*mcp.CallToolResult, error) {
 	deployTokenID, err := strconv.Atoi(args.TokenID.ID)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("invalid deploy token ID: %v", err)), nil
@@ -367,14 +465,14 @@ func handleDeleteDeployToken(args ManageDeployTokensArgs) (*mcp.CallToolResult,
 	var result string
 	
 	if args.Scope.Type == "project" {
-		_, err = util.GitlabClient().DeployTokens.DeleteProjectDeployToken(args.Scope.ProjectPath, deployTokenID)
+		_, err = util.GitlabClientFromContext(ctx).DeployTokens.DeleteProjectDeployToken(args.Scope.ProjectPath, deployTokenID, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to delete project deploy token: %v", err)), nil
 		}
 		
 		result = fmt.Sprintf("✅ Deploy token %s deleted successfully from project '%s'", args.TokenID.ID, args.Scope.ProjectPath)
 	} else { // group
-		_, err = util.GitlabClient().DeployTokens.DeleteGroupDeployToken(args.Scope.GroupID, deployTokenID)
+		_, err = util.GitlabClientFromContext(ctx).DeployTokens.DeleteGroupDeployToken(args.Scope.GroupID, deployTokenID, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to delete group deploy token: %v", err)), nil
 		}
@@ -384,3 +482,66 @@ func handleDeleteDeployToken(args ManageDeployTokensArgs) (*mcp.CallToolResult,
 
 	return mcp.NewToolResultText(result), nil
 }
+
+func deployStatusHandler(ctx context.Context, request mcp.CallToolRequest, args DeployStatusArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.ListEnvironmentsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: util.DefaultPerPage(100)},
+	}
+	if !args.IncludeStopped {
+		opt.States = gitlab.Ptr("available")
+	}
+
+	environments, _, err := util.GitlabClientFromContext(ctx).Environments.ListEnvironments(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list environments: %v", err)), nil
+	}
+
+	if len(environments) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No environments found for project %s\n", args.ProjectPath)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Deploy status for %s:\n\n", args.ProjectPath))
+	result.WriteString("| Environment | State | Ref | SHA | Deployment | Pipeline | Deployed At | By |\n")
+	result.WriteString("|---|---|---|---|---|---|---|---|\n")
+
+	for _, env := range environments {
+		// The list endpoint omits the last deployment, so read each environment
+		detail, _, err := util.GitlabClientFromContext(ctx).Environments.GetEnvironment(args.ProjectPath, env.ID, gitlab.WithContext(ctx))
+		if err != nil {
+			result.WriteString(fmt.Sprintf("| %s | %s | error: %v | | | | | |\n", env.Name, env.State, err))
+			continue
+		}
+
+		deployment := detail.LastDeployment
+		if deployment == nil {
+			result.WriteString(fmt.Sprintf("| %s | %s | - | - | never deployed | - | - | - |\n", env.Name, env.State))
+			continue
+		}
+
+		sha := deployment.SHA
+		if len(sha) > 8 {
+			sha = sha[:8]
+		}
+
+		pipeline := "-"
+		if deployment.Deployable.Pipeline.ID != 0 {
+			pipeline = fmt.Sprintf("#%d %s", deployment.Deployable.Pipeline.ID, deployment.Deployable.Pipeline.Status)
+		}
+
+		deployedAt := "-"
+		if deployment.CreatedAt != nil {
+			deployedAt = deployment.CreatedAt.Format("2006-01-02 15:04:05")
+		}
+
+		user := "-"
+		if deployment.User != nil {
+			user = deployment.User.Username
+		}
+
+		result.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s | %s | %s | %s |\n",
+			env.Name, env.State, deployment.Ref, sha, deployment.Status, pipeline, deployedAt, user))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
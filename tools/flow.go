@@ -64,6 +64,15 @@ type GitFlowListBranchesArgs struct {
 	BranchType  string `json:"branch_type" validate:"oneof=all feature release hotfix"`
 }
 
+type StartWorkArgs struct {
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=200"`
+	Branch      string `json:"branch" validate:"required,min=1,max=255"`
+	BaseBranch  string `json:"base_branch" validate:"max=255"`
+	Title       string `json:"title" validate:"required,min=1,max=255"`
+	Description string `json:"description" validate:"max=1000000"`
+	Confirmed   bool   `json:"confirmed,omitempty"`
+}
+
 // RegisterFlowTools registers all Git Flow related tools
 func RegisterFlowTools(s *server.MCPServer) {
 	// Unified branch creation tool
@@ -161,10 +170,22 @@ func RegisterFlowTools(s *server.MCPServer) {
 		mcp.WithString("branch_type", mcp.DefaultString("all"), mcp.Description("Branch type to list (feature, release, hotfix, all)")),
 	)
 
+	// Branch + draft MR in one step
+	startWorkTool := mcp.NewTool("start_work",
+		mcp.WithDescription("Start work on a task: create a branch from a base branch and open a draft MR targeting the base"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
+		mcp.WithString("branch", mcp.Required(), mcp.Description("Name of the new branch (must not exist yet)")),
+		mcp.WithString("base_branch", mcp.Description("Branch to create from and target with the MR (default: project default branch)")),
+		mcp.WithString("title", mcp.Required(), mcp.Description("Merge request title (Draft: prefix is added automatically)")),
+		mcp.WithString("description", mcp.Description("Merge request description")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required to create the branch and MR")),
+	)
+
 	// Register all tools
 	s.AddTool(createBranchTool, mcp.NewTypedToolHandler(gitFlowCreateBranchHandler))
 	s.AddTool(finishBranchTool, mcp.NewTypedToolHandler(gitFlowFinishBranchHandler))
 	s.AddTool(listFlowBranchesTool, mcp.NewTypedToolHandler(listFlowBranchesHandler))
+	s.AddTool(startWorkTool, mcp.NewTypedToolHandler(startWorkHandler))
 }
 
 // Unified branch creation handler
@@ -174,17 +195,17 @@ func gitFlowCreateBranchHandler(ctx context.Context, request mcp.CallToolRequest
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating a release branch."), nil
 		}
-		return createReleaseBranch(args)
+		return createReleaseBranch(ctx, args)
 	case "create_feature":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating a feature branch."), nil
 		}
-		return createFeatureBranch(args)
+		return createFeatureBranch(ctx, args)
 	case "create_hotfix":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating a hotfix branch."), nil
 		}
-		return createHotfixBranch(args)
+		return createHotfixBranch(ctx, args)
 	default:
 		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s", args.Action)), nil
 	}
@@ -197,24 +218,24 @@ func gitFlowFinishBranchHandler(ctx context.Context, request mcp.CallToolRequest
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with finishing a release branch."), nil
 		}
-		return finishReleaseBranch(args)
+		return finishReleaseBranch(ctx, args)
 	case "finish_feature":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with finishing a feature branch."), nil
 		}
-		return finishFeatureBranch(args)
+		return finishFeatureBranch(ctx, args)
 	case "finish_hotfix":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with finishing a hotfix branch."), nil
 		}
-		return finishHotfixBranch(args)
+		return finishHotfixBranch(ctx, args)
 	default:
 		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s", args.Action)), nil
 	}
 }
 
 // Release branch implementation
-func createReleaseBranch(args GitFlowCreateBranchArgs) (*mcp.CallToolResult, error) {
+func createReleaseBranch(ctx context.Context, args GitFlowCreateBranchArgs) (*mcp.CallToolResult, error) {
 	baseBranch := args.CreateOptions.BaseBranch
 	if baseBranch == "" {
 		developmentBranch := args.CreateOptions.DevelopmentBranch
@@ -227,9 +248,9 @@ func createReleaseBranch(args GitFlowCreateBranchArgs) (*mcp.CallToolResult, err
 	releaseBranch := fmt.Sprintf("release/%s", args.CreateOptions.ReleaseVersion)
 
 	// Check if release branch already exists
-	branches, _, err := util.GitlabClient().Branches.ListBranches(args.ProjectPath, &gitlab.ListBranchesOptions{
+	branches, _, err := util.GitlabClientFromContext(ctx).Branches.ListBranches(args.ProjectPath, &gitlab.ListBranchesOptions{
 		Search: gitlab.Ptr(releaseBranch),
-	})
+	}, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to check existing branches: %v", err)), nil
 	}
@@ -241,10 +262,10 @@ func createReleaseBranch(args GitFlowCreateBranchArgs) (*mcp.CallToolResult, err
 	}
 
 	// Create the release branch
-	branch, _, err := util.GitlabClient().Branches.CreateBranch(args.ProjectPath, &gitlab.CreateBranchOptions{
+	branch, _, err := util.GitlabClientFromContext(ctx).Branches.CreateBranch(args.ProjectPath, &gitlab.CreateBranchOptions{
 		Branch: gitlab.Ptr(releaseBranch),
 		Ref:    gitlab.Ptr(baseBranch),
-	})
+	}, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to create release branch: %v", err)), nil
 	}
@@ -265,7 +286,7 @@ func createReleaseBranch(args GitFlowCreateBranchArgs) (*mcp.CallToolResult, err
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func finishReleaseBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, error) {
+func finishReleaseBranch(ctx context.Context, args GitFlowFinishBranchArgs) (*mcp.CallToolResult, error) {
 	releaseBranch := fmt.Sprintf("release/%s", args.FinishOptions.ReleaseVersion)
 	
 	// Get branch names with defaults
@@ -280,7 +301,7 @@ func finishReleaseBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, err
 	}
 	
 	// Verify release branch exists
-	_, _, err := util.GitlabClient().Branches.GetBranch(args.ProjectPath, releaseBranch)
+	_, _, err := util.GitlabClientFromContext(ctx).Branches.GetBranch(args.ProjectPath, releaseBranch, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("release branch '%s' not found: %v", releaseBranch, err)), nil
 	}
@@ -289,12 +310,12 @@ func finishReleaseBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, err
 	result.WriteString(fmt.Sprintf("🚀 Finishing release %s\n\n", args.FinishOptions.ReleaseVersion))
 
 	// Create MR to development branch
-	developMR, _, err := util.GitlabClient().MergeRequests.CreateMergeRequest(args.ProjectPath, &gitlab.CreateMergeRequestOptions{
+	developMR, _, err := util.GitlabClientFromContext(ctx).MergeRequests.CreateMergeRequest(args.ProjectPath, &gitlab.CreateMergeRequestOptions{
 		Title:        gitlab.Ptr(fmt.Sprintf("Release %s", args.FinishOptions.ReleaseVersion)),
 		Description:  gitlab.Ptr(fmt.Sprintf("Release %s ready for merge to %s\n\n- [ ] Code review completed\n- [ ] Tests passing\n- [ ] Documentation updated", args.FinishOptions.ReleaseVersion, developmentBranch)),
 		SourceBranch: gitlab.Ptr(releaseBranch),
 		TargetBranch: gitlab.Ptr(developmentBranch),
-	})
+	}, gitlab.WithContext(ctx))
 	if err != nil {
 		result.WriteString(fmt.Sprintf("❌ Failed to create MR to %s: %v\n", developmentBranch, err))
 	} else {
@@ -303,12 +324,12 @@ func finishReleaseBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, err
 	}
 
 	// Create MR to production branch
-	masterMR, _, err := util.GitlabClient().MergeRequests.CreateMergeRequest(args.ProjectPath, &gitlab.CreateMergeRequestOptions{
+	masterMR, _, err := util.GitlabClientFromContext(ctx).MergeRequests.CreateMergeRequest(args.ProjectPath, &gitlab.CreateMergeRequestOptions{
 		Title:        gitlab.Ptr(fmt.Sprintf("Release %s", args.FinishOptions.ReleaseVersion)),
 		Description:  gitlab.Ptr(fmt.Sprintf("Release %s ready for production\n\n- [ ] Release notes prepared\n- [ ] Deployment plan reviewed\n- [ ] Rollback plan confirmed", args.FinishOptions.ReleaseVersion)),
 		SourceBranch: gitlab.Ptr(releaseBranch),
 		TargetBranch: gitlab.Ptr(productionBranch),
-	})
+	}, gitlab.WithContext(ctx))
 	if err != nil {
 		result.WriteString(fmt.Sprintf("❌ Failed to create MR to %s: %v\n", productionBranch, err))
 	} else {
@@ -318,7 +339,7 @@ func finishReleaseBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, err
 
 	// Delete branch if requested
 	if args.FinishOptions.DeleteBranch {
-		_, err := util.GitlabClient().Branches.DeleteBranch(args.ProjectPath, releaseBranch)
+		_, err := util.GitlabClientFromContext(ctx).Branches.DeleteBranch(args.ProjectPath, releaseBranch, gitlab.WithContext(ctx))
 		if err != nil {
 			result.WriteString(fmt.Sprintf("⚠️  Failed to delete release branch: %v\n", err))
 		} else {
@@ -332,7 +353,7 @@ func finishReleaseBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, err
 }
 
 // Feature branch implementation
-func createFeatureBranch(args GitFlowCreateBranchArgs) (*mcp.CallToolResult, error) {
+func createFeatureBranch(ctx context.Context, args GitFlowCreateBranchArgs) (*mcp.CallToolResult, error) {
 	baseBranch := args.CreateOptions.BaseBranch
 	if baseBranch == "" {
 		developmentBranch := args.CreateOptions.DevelopmentBranch
@@ -345,9 +366,9 @@ func createFeatureBranch(args GitFlowCreateBranchArgs) (*mcp.CallToolResult, err
 	featureBranch := fmt.Sprintf("feature/%s", args.CreateOptions.FeatureName)
 
 	// Check if feature branch already exists
-	branches, _, err := util.GitlabClient().Branches.ListBranches(args.ProjectPath, &gitlab.ListBranchesOptions{
+	branches, _, err := util.GitlabClientFromContext(ctx).Branches.ListBranches(args.ProjectPath, &gitlab.ListBranchesOptions{
 		Search: gitlab.Ptr(featureBranch),
-	})
+	}, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to check existing branches: %v", err)), nil
 	}
@@ -359,10 +380,10 @@ func createFeatureBranch(args GitFlowCreateBranchArgs) (*mcp.CallToolResult, err
 	}
 
 	// Create the feature branch
-	branch, _, err := util.GitlabClient().Branches.CreateBranch(args.ProjectPath, &gitlab.CreateBranchOptions{
+	branch, _, err := util.GitlabClientFromContext(ctx).Branches.CreateBranch(args.ProjectPath, &gitlab.CreateBranchOptions{
 		Branch: gitlab.Ptr(featureBranch),
 		Ref:    gitlab.Ptr(baseBranch),
-	})
+	}, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to create feature branch: %v", err)), nil
 	}
@@ -383,7 +404,7 @@ func createFeatureBranch(args GitFlowCreateBranchArgs) (*mcp.CallToolResult, err
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func finishFeatureBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, error) {
+func finishFeatureBranch(ctx context.Context, args GitFlowFinishBranchArgs) (*mcp.CallToolResult, error) {
 	featureBranch := fmt.Sprintf("feature/%s", args.FinishOptions.FeatureName)
 	targetBranch := args.FinishOptions.TargetBranch
 	if targetBranch == "" {
@@ -395,7 +416,7 @@ func finishFeatureBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, err
 	}
 	
 	// Verify feature branch exists
-	_, _, err := util.GitlabClient().Branches.GetBranch(args.ProjectPath, featureBranch)
+	_, _, err := util.GitlabClientFromContext(ctx).Branches.GetBranch(args.ProjectPath, featureBranch, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("feature branch '%s' not found: %v", featureBranch, err)), nil
 	}
@@ -404,12 +425,12 @@ func finishFeatureBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, err
 	result.WriteString(fmt.Sprintf("🚀 Finishing feature %s\n\n", args.FinishOptions.FeatureName))
 
 	// Create MR to target branch (usually develop)
-	mr, _, err := util.GitlabClient().MergeRequests.CreateMergeRequest(args.ProjectPath, &gitlab.CreateMergeRequestOptions{
+	mr, _, err := util.GitlabClientFromContext(ctx).MergeRequests.CreateMergeRequest(args.ProjectPath, &gitlab.CreateMergeRequestOptions{
 		Title:        gitlab.Ptr(fmt.Sprintf("Feature: %s", args.FinishOptions.FeatureName)),
 		Description:  gitlab.Ptr(fmt.Sprintf("Feature implementation: %s\n\n- [ ] Code review completed\n- [ ] Tests added/updated\n- [ ] Documentation updated\n- [ ] Ready for merge", args.FinishOptions.FeatureName)),
 		SourceBranch: gitlab.Ptr(featureBranch),
 		TargetBranch: gitlab.Ptr(targetBranch),
-	})
+	}, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to create MR: %v", err)), nil
 	}
@@ -419,7 +440,7 @@ func finishFeatureBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, err
 
 	// Delete branch if requested
 	if args.FinishOptions.DeleteBranch {
-		_, err := util.GitlabClient().Branches.DeleteBranch(args.ProjectPath, featureBranch)
+		_, err := util.GitlabClientFromContext(ctx).Branches.DeleteBranch(args.ProjectPath, featureBranch, gitlab.WithContext(ctx))
 		if err != nil {
 			result.WriteString(fmt.Sprintf("⚠️  Failed to delete feature branch: %v\n", err))
 		} else {
@@ -433,7 +454,7 @@ func finishFeatureBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, err
 }
 
 // Hotfix branch implementation
-func createHotfixBranch(args GitFlowCreateBranchArgs) (*mcp.CallToolResult, error) {
+func createHotfixBranch(ctx context.Context, args GitFlowCreateBranchArgs) (*mcp.CallToolResult, error) {
 	baseBranch := args.CreateOptions.BaseBranch
 	if baseBranch == "" {
 		productionBranch := args.CreateOptions.ProductionBranch
@@ -446,9 +467,9 @@ func createHotfixBranch(args GitFlowCreateBranchArgs) (*mcp.CallToolResult, erro
 	hotfixBranch := fmt.Sprintf("hotfix/%s", args.CreateOptions.HotfixVersion)
 
 	// Check if hotfix branch already exists
-	branches, _, err := util.GitlabClient().Branches.ListBranches(args.ProjectPath, &gitlab.ListBranchesOptions{
+	branches, _, err := util.GitlabClientFromContext(ctx).Branches.ListBranches(args.ProjectPath, &gitlab.ListBranchesOptions{
 		Search: gitlab.Ptr(hotfixBranch),
-	})
+	}, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to check existing branches: %v", err)), nil
 	}
@@ -460,10 +481,10 @@ func createHotfixBranch(args GitFlowCreateBranchArgs) (*mcp.CallToolResult, erro
 	}
 
 	// Create the hotfix branch
-	branch, _, err := util.GitlabClient().Branches.CreateBranch(args.ProjectPath, &gitlab.CreateBranchOptions{
+	branch, _, err := util.GitlabClientFromContext(ctx).Branches.CreateBranch(args.ProjectPath, &gitlab.CreateBranchOptions{
 		Branch: gitlab.Ptr(hotfixBranch),
 		Ref:    gitlab.Ptr(baseBranch),
-	})
+	}, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to create hotfix branch: %v", err)), nil
 	}
@@ -484,7 +505,7 @@ func createHotfixBranch(args GitFlowCreateBranchArgs) (*mcp.CallToolResult, erro
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func finishHotfixBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, error) {
+func finishHotfixBranch(ctx context.Context, args GitFlowFinishBranchArgs) (*mcp.CallToolResult, error) {
 	hotfixBranch := fmt.Sprintf("hotfix/%s", args.FinishOptions.HotfixVersion)
 	
 	// Get branch names with defaults
@@ -499,7 +520,7 @@ func finishHotfixBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, erro
 	}
 	
 	// Verify hotfix branch exists
-	_, _, err := util.GitlabClient().Branches.GetBranch(args.ProjectPath, hotfixBranch)
+	_, _, err := util.GitlabClientFromContext(ctx).Branches.GetBranch(args.ProjectPath, hotfixBranch, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("hotfix branch '%s' not found: %v", hotfixBranch, err)), nil
 	}
@@ -508,12 +529,12 @@ func finishHotfixBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, erro
 	result.WriteString(fmt.Sprintf("🚨 Finishing hotfix %s\n\n", args.FinishOptions.HotfixVersion))
 
 	// Create MR to production branch
-	masterMR, _, err := util.GitlabClient().MergeRequests.CreateMergeRequest(args.ProjectPath, &gitlab.CreateMergeRequestOptions{
+	masterMR, _, err := util.GitlabClientFromContext(ctx).MergeRequests.CreateMergeRequest(args.ProjectPath, &gitlab.CreateMergeRequestOptions{
 		Title:        gitlab.Ptr(fmt.Sprintf("Hotfix %s", args.FinishOptions.HotfixVersion)),
 		Description:  gitlab.Ptr(fmt.Sprintf("Critical hotfix %s\n\n- [ ] Fix verified\n- [ ] Tests passing\n- [ ] Ready for immediate deployment", args.FinishOptions.HotfixVersion)),
 		SourceBranch: gitlab.Ptr(hotfixBranch),
 		TargetBranch: gitlab.Ptr(productionBranch),
-	})
+	}, gitlab.WithContext(ctx))
 	if err != nil {
 		result.WriteString(fmt.Sprintf("❌ Failed to create MR to %s: %v\n", productionBranch, err))
 	} else {
@@ -522,12 +543,12 @@ func finishHotfixBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, erro
 	}
 
 	// Create MR to development branch
-	developMR, _, err := util.GitlabClient().MergeRequests.CreateMergeRequest(args.ProjectPath, &gitlab.CreateMergeRequestOptions{
+	developMR, _, err := util.GitlabClientFromContext(ctx).MergeRequests.CreateMergeRequest(args.ProjectPath, &gitlab.CreateMergeRequestOptions{
 		Title:        gitlab.Ptr(fmt.Sprintf("Hotfix %s", args.FinishOptions.HotfixVersion)),
 		Description:  gitlab.Ptr(fmt.Sprintf("Hotfix %s merge to %s\n\n- [ ] Conflicts resolved\n- [ ] Tests updated if needed", args.FinishOptions.HotfixVersion, developmentBranch)),
 		SourceBranch: gitlab.Ptr(hotfixBranch),
 		TargetBranch: gitlab.Ptr(developmentBranch),
-	})
+	}, gitlab.WithContext(ctx))
 	if err != nil {
 		result.WriteString(fmt.Sprintf("❌ Failed to create MR to %s: %v\n", developmentBranch, err))
 	} else {
@@ -537,7 +558,7 @@ func finishHotfixBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, erro
 
 	// Delete branch if requested
 	if args.FinishOptions.DeleteBranch {
-		_, err := util.GitlabClient().Branches.DeleteBranch(args.ProjectPath, hotfixBranch)
+		_, err := util.GitlabClientFromContext(ctx).Branches.DeleteBranch(args.ProjectPath, hotfixBranch, gitlab.WithContext(ctx))
 		if err != nil {
 			result.WriteString(fmt.Sprintf("⚠️  Failed to delete hotfix branch: %v\n", err))
 		} else {
@@ -552,11 +573,11 @@ func finishHotfixBranch(args GitFlowFinishBranchArgs) (*mcp.CallToolResult, erro
 
 // List branches handler (keeping existing implementation)
 func listFlowBranchesHandler(ctx context.Context, request mcp.CallToolRequest, args GitFlowListBranchesArgs) (*mcp.CallToolResult, error) {
-	branches, _, err := util.GitlabClient().Branches.ListBranches(args.ProjectPath, &gitlab.ListBranchesOptions{
+	branches, _, err := util.GitlabClientFromContext(ctx).Branches.ListBranches(args.ProjectPath, &gitlab.ListBranchesOptions{
 		ListOptions: gitlab.ListOptions{
-			PerPage: 100,
+			PerPage: util.DefaultPerPage(100),
 		},
-	})
+	}, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to list branches: %v", err)), nil
 	}
@@ -624,4 +645,72 @@ func listFlowBranchesHandler(ctx context.Context, request mcp.CallToolRequest, a
 		len(featureBranches), len(releaseBranches), len(hotfixBranches)))
 
 	return mcp.NewToolResultText(result.String()), nil
-}
\ No newline at end of file
+}
+
+// Start work handler: branch + draft MR
+func startWorkHandler(ctx context.Context, request mcp.CallToolRequest, args StartWorkArgs) (*mcp.CallToolResult, error) {
+	if !args.Confirmed {
+		return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating the branch and draft merge request."), nil
+	}
+
+	baseBranch := args.BaseBranch
+	if baseBranch == "" {
+		project, _, err := util.GitlabClientFromContext(ctx).Projects.GetProject(args.ProjectPath, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to get project: %v", err)), nil
+		}
+		baseBranch = project.DefaultBranch
+	}
+
+	// Refuse to reuse an existing branch
+	if _, _, err := util.GitlabClientFromContext(ctx).Branches.GetBranch(args.ProjectPath, args.Branch, gitlab.WithContext(ctx)); err == nil {
+		return mcp.NewToolResultError(fmt.Sprintf("branch '%s' already exists", args.Branch)), nil
+	}
+
+	branch, _, err := util.GitlabClientFromContext(ctx).Branches.CreateBranch(args.ProjectPath, &gitlab.CreateBranchOptions{
+		Branch: gitlab.Ptr(args.Branch),
+		Ref:    gitlab.Ptr(baseBranch),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to create branch: %v", err)), nil
+	}
+
+	title := args.Title
+	if !strings.HasPrefix(strings.ToLower(title), "draft:") {
+		title = "Draft: " + title
+	}
+
+	opt := &gitlab.CreateMergeRequestOptions{
+		Title:        gitlab.Ptr(title),
+		SourceBranch: gitlab.Ptr(args.Branch),
+		TargetBranch: gitlab.Ptr(baseBranch),
+	}
+	if args.Description != "" {
+		opt.Description = gitlab.Ptr(args.Description)
+	}
+
+	var result strings.Builder
+	result.WriteString("✅ Branch created successfully!\n\n")
+	result.WriteString(fmt.Sprintf("Branch: %s\n", branch.Name))
+	result.WriteString(fmt.Sprintf("Based on: %s\n", baseBranch))
+	result.WriteString(fmt.Sprintf("Commit: %s\n\n", branch.Commit.ID))
+
+	mr, _, err := util.GitlabClientFromContext(ctx).MergeRequests.CreateMergeRequest(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		// The branch was just created for this MR, so don't leave it behind on its own
+		if _, delErr := util.GitlabClientFromContext(ctx).Branches.DeleteBranch(args.ProjectPath, args.Branch, gitlab.WithContext(ctx)); delErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to create draft MR: %v. Branch '%s' was created but could not be deleted (%v); delete it or open the MR manually", err, args.Branch, delErr)), nil
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("failed to create draft MR: %v. Branch '%s' was deleted again", err, args.Branch)), nil
+	}
+
+	result.WriteString(fmt.Sprintf("✅ Created draft MR to %s: !%d\n", baseBranch, mr.IID))
+	result.WriteString(fmt.Sprintf("   Title: %s\n", mr.Title))
+	result.WriteString(fmt.Sprintf("   URL: %s\n\n", mr.WebURL))
+
+	result.WriteString("🔄 Next steps:\n")
+	result.WriteString("1. Push your commits to the branch\n")
+	result.WriteString("2. Mark the MR as ready when the work is done\n")
+
+	return mcp.NewToolResultText(result.String()), nil
+}
@@ -0,0 +1,56 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/nguyenvanduocit/gitlab-mcp/util"
+)
+
+// newTestContext starts a fake GitLab API served by mux and returns a context
+// whose GitLab client talks to it. Project paths arrive escaped, so patterns
+// must use e.g. /api/v4/projects/group%2Fproject/...
+func newTestContext(t *testing.T, mux *http.ServeMux) context.Context {
+	t.Helper()
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	ctx := util.WithToken(context.Background(), "test-token")
+	return util.WithBaseURL(ctx, srv.URL)
+}
+
+// writeJSON encodes v as the response body
+func writeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Errorf("failed to encode response: %v", err)
+	}
+}
+
+// resultText returns the text of a tool result, failing the test on error results
+func resultText(t *testing.T, result *mcp.CallToolResult, err error) string {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+
+	var text strings.Builder
+	for _, content := range result.Content {
+		if c, ok := content.(mcp.TextContent); ok {
+			text.WriteString(c.Text)
+		}
+	}
+	if result.IsError {
+		t.Fatalf("handler returned error result: %s", text.String())
+	}
+	return text.String()
+}
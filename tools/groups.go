@@ -21,6 +21,24 @@ type ListGroupsArgs struct {
 	MinAccess  string `json:"min_access_level" validate:"omitempty,oneof=guest reporter developer maintainer owner"`
 }
 
+type CreateGroupArgs struct {
+	Name        string `json:"name" validate:"required,min=1,max=255"`
+	Path        string `json:"path" validate:"required,min=1,max=255"`
+	Description string `json:"description,omitempty" validate:"omitempty,max=2000"`
+	Visibility  string `json:"visibility,omitempty" validate:"omitempty,oneof=private internal public"`
+	ParentID    int    `json:"parent_id,omitempty" validate:"omitempty,min=1"`
+	Confirmed   bool   `json:"confirmed,omitempty"`
+}
+
+type GetGroupArgs struct {
+	GroupID string `json:"group_id" validate:"required,min=1"`
+}
+
+type ListNamespacesArgs struct {
+	Search    string `json:"search" validate:"omitempty,min=1,max=100"`
+	OwnedOnly bool   `json:"owned_only"`
+}
+
 func RegisterGroupTools(s *server.MCPServer) {
 	listGroupUsersTool := mcp.NewTool("list_group_users",
 		mcp.WithDescription("List all users in a GitLab group"),
@@ -35,16 +53,40 @@ func RegisterGroupTools(s *server.MCPServer) {
 		mcp.WithString("min_access_level", mcp.Description("Minimum access level (guest, reporter, developer, maintainer, owner)")),
 	)
 	s.AddTool(listGroupsTool, mcp.NewTypedToolHandler(listGroupsHandler))
+
+	createGroupTool := mcp.NewTool("create_group",
+		mcp.WithDescription("Create a GitLab group, or a subgroup when parent_id is given"),
+		mcp.WithString("name", mcp.Required(), mcp.Description("Group name")),
+		mcp.WithString("path", mcp.Required(), mcp.Description("Group path used in URLs")),
+		mcp.WithString("description", mcp.Description("Group description")),
+		mcp.WithString("visibility", mcp.Description("Visibility: private, internal, public (default: private)")),
+		mcp.WithNumber("parent_id", mcp.Description("ID of the parent group to create a subgroup in")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required to create the group")),
+	)
+	s.AddTool(createGroupTool, mcp.NewTypedToolHandler(createGroupHandler))
+
+	getGroupTool := mcp.NewTool("get_group",
+		mcp.WithDescription("Get a GitLab group's details, including its project and subgroup counts and statistics when available"),
+		mcp.WithString("group_id", mcp.Required(), mcp.Description("GitLab group ID or full path")),
+	)
+	s.AddTool(getGroupTool, mcp.NewTypedToolHandler(getGroupHandler))
+
+	listNamespacesTool := mcp.NewTool("list_namespaces",
+		mcp.WithDescription("List user and group namespaces with their IDs, e.g. to find where a new project can be created"),
+		mcp.WithString("search", mcp.Description("Search for namespaces by name or path")),
+		mcp.WithBoolean("owned_only", mcp.Description("List only namespaces owned by the authenticated user")),
+	)
+	s.AddTool(listNamespacesTool, mcp.NewTypedToolHandler(listNamespacesHandler))
 }
 
 func listGroupUsersHandler(ctx context.Context, request mcp.CallToolRequest, args ListGroupUsersArgs) (*mcp.CallToolResult, error) {
 	opt := &gitlab.ListGroupMembersOptions{
 		ListOptions: gitlab.ListOptions{
-			PerPage: 100,
+			PerPage: util.DefaultPerPage(100),
 		},
 	}
 
-	members, _, err := util.GitlabClient().Groups.ListGroupMembers(args.GroupID, opt)
+	members, _, err := util.GitlabClientFromContext(ctx).Groups.ListGroupMembers(args.GroupID, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to list group members: %v", err)), nil
 	}
@@ -85,10 +127,28 @@ func getAccessLevelString(level gitlab.AccessLevelValue) string {
 	}
 }
 
+// parseAccessLevelName converts an access level name such as "developer" to its value
+func parseAccessLevelName(name string) (gitlab.AccessLevelValue, error) {
+	switch strings.ToLower(name) {
+	case "guest":
+		return gitlab.GuestPermissions, nil
+	case "reporter":
+		return gitlab.ReporterPermissions, nil
+	case "developer":
+		return gitlab.DeveloperPermissions, nil
+	case "maintainer":
+		return gitlab.MaintainerPermissions, nil
+	case "owner":
+		return gitlab.OwnerPermissions, nil
+	default:
+		return 0, fmt.Errorf("%s. Valid values: guest, reporter, developer, maintainer, owner", name)
+	}
+}
+
 func listGroupsHandler(ctx context.Context, request mcp.CallToolRequest, args ListGroupsArgs) (*mcp.CallToolResult, error) {
 	opt := &gitlab.ListGroupsOptions{
 		ListOptions: gitlab.ListOptions{
-			PerPage: 100,
+			PerPage: util.DefaultPerPage(100),
 		},
 		OrderBy: gitlab.Ptr("name"),
 		Sort:    gitlab.Ptr("asc"),
@@ -106,23 +166,14 @@ func listGroupsHandler(ctx context.Context, request mcp.CallToolRequest, args Li
 
 	// Apply minimum access level filter if provided
 	if args.MinAccess != "" {
-		switch strings.ToLower(args.MinAccess) {
-		case "guest":
-			opt.MinAccessLevel = gitlab.Ptr(gitlab.GuestPermissions)
-		case "reporter":
-			opt.MinAccessLevel = gitlab.Ptr(gitlab.ReporterPermissions)
-		case "developer":
-			opt.MinAccessLevel = gitlab.Ptr(gitlab.DeveloperPermissions)
-		case "maintainer":
-			opt.MinAccessLevel = gitlab.Ptr(gitlab.MaintainerPermissions)
-		case "owner":
-			opt.MinAccessLevel = gitlab.Ptr(gitlab.OwnerPermissions)
-		default:
-			return mcp.NewToolResultError(fmt.Sprintf("invalid min_access_level: %s. Valid values: guest, reporter, developer, maintainer, owner", args.MinAccess)), nil
+		level, err := parseAccessLevelName(args.MinAccess)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid min_access_level: %v", err)), nil
 		}
+		opt.MinAccessLevel = gitlab.Ptr(level)
 	}
 
-	groups, _, err := util.GitlabClient().Groups.ListGroups(opt)
+	groups, _, err := util.GitlabClientFromContext(ctx).Groups.ListGroups(opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to list groups: %v", err)), nil
 	}
@@ -131,33 +182,7 @@ func listGroupsHandler(ctx context.Context, request mcp.CallToolRequest, args Li
 	result.WriteString("GitLab Groups:\n\n")
 
 	for _, group := range groups {
-		result.WriteString(fmt.Sprintf("Group: %s\n", group.Name))
-		result.WriteString(fmt.Sprintf("Path: %s\n", group.Path))
-		result.WriteString(fmt.Sprintf("Full Path: %s\n", group.FullPath))
-		result.WriteString(fmt.Sprintf("ID: %d\n", group.ID))
-		result.WriteString(fmt.Sprintf("Visibility: %s\n", group.Visibility))
-		result.WriteString(fmt.Sprintf("Web URL: %s\n", group.WebURL))
-		
-		if group.Description != "" {
-			result.WriteString(fmt.Sprintf("Description: %s\n", group.Description))
-		}
-		
-		if group.AvatarURL != "" {
-			result.WriteString(fmt.Sprintf("Avatar: %s\n", group.AvatarURL))
-		}
-		
-		result.WriteString(fmt.Sprintf("Created: %s\n", group.CreatedAt.Format("2006-01-02 15:04:05")))
-		
-		// Show parent group if available
-		if group.ParentID != 0 {
-			result.WriteString(fmt.Sprintf("Parent ID: %d\n", group.ParentID))
-		}
-		
-		// Show statistics if available
-		if group.Statistics != nil {
-			result.WriteString(fmt.Sprintf("Repository Size: %d bytes\n", group.Statistics.RepositorySize))
-		}
-		
+		formatGroup(&result, group)
 		result.WriteString("\n")
 	}
 
@@ -166,4 +191,149 @@ func listGroupsHandler(ctx context.Context, request mcp.CallToolRequest, args Li
 	}
 
 	return mcp.NewToolResultText(result.String()), nil
-} 
\ No newline at end of file
+}
+
+// formatGroup writes the details shown for each group in list_groups and get_group
+func formatGroup(result *strings.Builder, group *gitlab.Group) {
+	result.WriteString(fmt.Sprintf("Group: %s\n", group.Name))
+	result.WriteString(fmt.Sprintf("Path: %s\n", group.Path))
+	result.WriteString(fmt.Sprintf("Full Path: %s\n", group.FullPath))
+	result.WriteString(fmt.Sprintf("ID: %d\n", group.ID))
+	result.WriteString(fmt.Sprintf("Visibility: %s\n", group.Visibility))
+	result.WriteString(fmt.Sprintf("Web URL: %s\n", group.WebURL))
+
+	if group.Description != "" {
+		result.WriteString(fmt.Sprintf("Description: %s\n", group.Description))
+	}
+
+	if group.AvatarURL != "" {
+		result.WriteString(fmt.Sprintf("Avatar: %s\n", group.AvatarURL))
+	}
+
+	result.WriteString(fmt.Sprintf("Created: %s\n", group.CreatedAt.Format("2006-01-02 15:04:05")))
+
+	// Show parent group if available
+	if group.ParentID != 0 {
+		result.WriteString(fmt.Sprintf("Parent ID: %d\n", group.ParentID))
+	}
+
+	// Show statistics if available
+	if group.Statistics != nil {
+		result.WriteString(fmt.Sprintf("Repository Size: %d bytes\n", group.Statistics.RepositorySize))
+	}
+}
+
+func createGroupHandler(ctx context.Context, request mcp.CallToolRequest, args CreateGroupArgs) (*mcp.CallToolResult, error) {
+	if !args.Confirmed {
+		return mcp.NewToolResultError(fmt.Sprintf("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating group %s.", args.Name)), nil
+	}
+
+	opt := &gitlab.CreateGroupOptions{
+		Name: gitlab.Ptr(args.Name),
+		Path: gitlab.Ptr(args.Path),
+	}
+	if args.Description != "" {
+		opt.Description = gitlab.Ptr(args.Description)
+	}
+	if args.Visibility != "" {
+		opt.Visibility = gitlab.Ptr(gitlab.VisibilityValue(args.Visibility))
+	}
+	if args.ParentID > 0 {
+		opt.ParentID = gitlab.Ptr(args.ParentID)
+	}
+
+	group, _, err := util.GitlabClientFromContext(ctx).Groups.CreateGroup(opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to create group: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString("✅ Group created\n\n")
+	formatGroup(&result, group)
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func getGroupHandler(ctx context.Context, request mcp.CallToolRequest, args GetGroupArgs) (*mcp.CallToolResult, error) {
+	client := util.GitlabClientFromContext(ctx)
+
+	group, _, err := client.Groups.GetGroup(args.GroupID, &gitlab.GetGroupOptions{
+		WithProjects: gitlab.Ptr(false),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get group: %v", err)), nil
+	}
+
+	// One-item pages are enough, the totals come from the pagination headers
+	_, projectsResp, err := client.Groups.ListGroupProjects(args.GroupID, &gitlab.ListGroupProjectsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 1},
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to count group projects: %v", err)), nil
+	}
+
+	_, subgroupsResp, err := client.Groups.ListSubGroups(args.GroupID, &gitlab.ListSubGroupsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 1},
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to count subgroups: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString("Group Details:\n")
+	formatGroup(&result, group)
+	result.WriteString(fmt.Sprintf("Projects: %d\n", projectsResp.TotalItems))
+	result.WriteString(fmt.Sprintf("Subgroups: %d\n", subgroupsResp.TotalItems))
+
+	if group.Statistics != nil {
+		result.WriteString("\nStatistics:\n")
+		result.WriteString(fmt.Sprintf("Storage Size: %d bytes\n", group.Statistics.StorageSize))
+		result.WriteString(fmt.Sprintf("LFS Objects Size: %d bytes\n", group.Statistics.LFSObjectsSize))
+		result.WriteString(fmt.Sprintf("Job Artifacts Size: %d bytes\n", group.Statistics.JobArtifactsSize))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func listNamespacesHandler(ctx context.Context, request mcp.CallToolRequest, args ListNamespacesArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.ListNamespacesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+
+	if args.Search != "" {
+		opt.Search = gitlab.Ptr(args.Search)
+	}
+
+	if args.OwnedOnly {
+		opt.OwnedOnly = gitlab.Ptr(true)
+	}
+
+	namespaces, _, err := util.GitlabClientFromContext(ctx).Namespaces.ListNamespaces(opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list namespaces: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString("GitLab Namespaces:\n\n")
+
+	for _, namespace := range namespaces {
+		result.WriteString(fmt.Sprintf("Namespace: %s\n", namespace.Name))
+		result.WriteString(fmt.Sprintf("ID: %d\n", namespace.ID))
+		result.WriteString(fmt.Sprintf("Kind: %s\n", namespace.Kind))
+		result.WriteString(fmt.Sprintf("Full Path: %s\n", namespace.FullPath))
+		if namespace.ParentID != 0 {
+			result.WriteString(fmt.Sprintf("Parent ID: %d\n", namespace.ParentID))
+		}
+		if namespace.WebURL != "" {
+			result.WriteString(fmt.Sprintf("Web URL: %s\n", namespace.WebURL))
+		}
+		result.WriteString("\n")
+	}
+
+	if len(namespaces) == 0 {
+		result.WriteString("No namespaces found matching the criteria.\n")
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
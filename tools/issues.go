@@ -0,0 +1,915 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/gitlab-mcp/util"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Consolidated issue management arguments with action-based routing
+type IssueManagementArgs struct {
+	Action      string `json:"action" validate:"required,oneof=list get create update close reopen"`
+	ProjectPath string `json:"project_path" validate:"required,min=1"`
+	IssueIID    string `json:"issue_iid,omitempty" validate:"omitempty,min=1"`
+	Confirmed   bool   `json:"confirmed,omitempty"`
+
+	// List action specific
+	ListOptions struct {
+		State  string `json:"state,omitempty" validate:"omitempty,oneof=opened closed all"`
+		Labels string `json:"labels,omitempty"`
+		Search string `json:"search,omitempty" validate:"omitempty,min=1,max=200"`
+	} `json:"list_options,omitempty"`
+
+	// Create action specific
+	CreateOptions IssueOptions `json:"create_options,omitempty"`
+
+	// Update action specific
+	UpdateOptions IssueOptions `json:"update_options,omitempty"`
+}
+
+// Fields shared by the create and update actions
+type IssueOptions struct {
+	Title       string `json:"title,omitempty" validate:"omitempty,min=1,max=255"`
+	Description string `json:"description,omitempty" validate:"max=1000000"`
+	Labels      string `json:"labels,omitempty"`
+	AssigneeIDs []int  `json:"assignee_ids,omitempty" validate:"omitempty,dive,min=1"`
+	MilestoneID int    `json:"milestone_id,omitempty" validate:"omitempty,min=1"`
+	DueDate     string `json:"due_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type DueDateReportArgs struct {
+	ProjectPath          string `json:"project_path,omitempty" validate:"omitempty,min=1"`
+	GroupID              string `json:"group_id,omitempty" validate:"omitempty,min=1"`
+	Days                 *int   `json:"days,omitempty" validate:"omitempty,min=0,max=365"`
+	IncludeMergeRequests bool   `json:"include_merge_requests,omitempty"`
+}
+
+type IssueStatisticsArgs struct {
+	ProjectPath      string `json:"project_path" validate:"required,min=1"`
+	Labels           string `json:"labels,omitempty"`
+	AssigneeUsername string `json:"assignee_username,omitempty" validate:"omitempty,min=1"`
+	Milestone        string `json:"milestone,omitempty" validate:"omitempty,min=1"`
+	Search           string `json:"search,omitempty" validate:"omitempty,min=1,max=200"`
+}
+
+type ListBoardsArgs struct {
+	ProjectPath string `json:"project_path,omitempty" validate:"required_without=GroupID,omitempty,min=1"`
+	GroupID     string `json:"group_id,omitempty" validate:"required_without=ProjectPath,omitempty,min=1"`
+}
+
+type ListGroupIssuesArgs struct {
+	GroupID   string `json:"group_id" validate:"required,min=1"`
+	State     string `json:"state,omitempty" validate:"omitempty,oneof=opened closed all"`
+	Labels    string `json:"labels,omitempty"`
+	Milestone string `json:"milestone,omitempty" validate:"omitempty,min=1"`
+}
+
+// Upper bound on issues gathered across pages for one list
+const maxListedIssues = 500
+
+type IssueLinksArgs struct {
+	Action            string `json:"action" validate:"required,oneof=list create delete"`
+	ProjectPath       string `json:"project_path" validate:"required,min=1"`
+	IssueIID          int    `json:"issue_iid" validate:"required,min=1"`
+	TargetProjectPath string `json:"target_project_path,omitempty" validate:"omitempty,min=1"`
+	TargetIssueIID    int    `json:"target_issue_iid,omitempty" validate:"omitempty,min=1"`
+	LinkType          string `json:"link_type,omitempty" validate:"omitempty,oneof=relates_to blocks is_blocked_by"`
+	LinkID            int    `json:"link_id,omitempty" validate:"omitempty,min=1"`
+	Confirmed         bool   `json:"confirmed,omitempty"`
+}
+
+// Default look-ahead window for the due date report
+const defaultDueSoonDays = 7
+
+func RegisterIssueTools(s *server.MCPServer) {
+	issueOptionProperties := map[string]any{
+		"title": map[string]any{
+			"type":        "string",
+			"description": "Issue title",
+		},
+		"description": map[string]any{
+			"type":        "string",
+			"description": "Issue description",
+		},
+		"labels": map[string]any{
+			"type":        "string",
+			"description": "Comma-separated list of labels",
+		},
+		"assignee_ids": map[string]any{
+			"type":        "array",
+			"description": "User IDs to assign",
+			"items": map[string]any{
+				"type": "integer",
+			},
+		},
+		"milestone_id": map[string]any{
+			"type":        "integer",
+			"description": "Milestone ID",
+		},
+		"due_date": map[string]any{
+			"type":        "string",
+			"description": "Due date (YYYY-MM-DD)",
+			"pattern":     "^\\d{4}-\\d{2}-\\d{2}$",
+		},
+	}
+
+	issueManagementTool := mcp.NewTool("manage_issues",
+		mcp.WithDescription("Comprehensive issue management with multiple actions: list, get, create, update, close, reopen"),
+		mcp.WithString("action",
+			mcp.Required(),
+			mcp.Description("Action to perform: list, get, create, update, close, reopen")),
+		mcp.WithString("project_path",
+			mcp.Required(),
+			mcp.Description("Project/repo path")),
+		mcp.WithString("issue_iid",
+			mcp.Description("Issue IID (required for get, update, close, reopen actions)")),
+		mcp.WithBoolean("confirmed",
+			mcp.Description("Confirmation required for create, update, close, reopen actions")),
+
+		// List options
+		mcp.WithObject("list_options",
+			mcp.Description("Options for list action"),
+			mcp.Properties(map[string]any{
+				"state": map[string]any{
+					"type":        "string",
+					"description": "Issue state (opened/closed/all)",
+					"default":     "all",
+				},
+				"labels": map[string]any{
+					"type":        "string",
+					"description": "Comma-separated list of labels the issues must have",
+				},
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Search issues by title and description",
+				},
+			}),
+		),
+
+		// Create options
+		mcp.WithObject("create_options",
+			mcp.Description("Options for create action (title is required)"),
+			mcp.Properties(issueOptionProperties),
+		),
+
+		// Update options
+		mcp.WithObject("update_options",
+			mcp.Description("Options for update action; only the provided fields are changed"),
+			mcp.Properties(issueOptionProperties),
+		),
+	)
+
+	dueDateReportTool := mcp.NewTool("due_date_report",
+		mcp.WithDescription("Report open issues that are overdue or due within the next N days for a project or group. Merge requests can be included based on their milestone due date."),
+		mcp.WithString("project_path", mcp.Description("Project/repo path (either project_path or group_id is required)")),
+		mcp.WithString("group_id", mcp.Description("Group ID or path to report across all its projects")),
+		mcp.WithNumber("days", mcp.Description("Look-ahead window in days for items that are due soon (default: 7, 0 reports only overdue and due today)")),
+		mcp.WithBoolean("include_merge_requests", mcp.Description("Also report open merge requests whose milestone is overdue or due soon")),
+	)
+
+	issueStatisticsTool := mcp.NewTool("list_project_issues_statistics",
+		mcp.WithDescription("Count open, closed and total issues in a project matching optional filters, without listing them"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
+		mcp.WithString("labels", mcp.Description("Comma-separated list of labels the issues must have")),
+		mcp.WithString("assignee_username", mcp.Description("Only count issues assigned to this user")),
+		mcp.WithString("milestone", mcp.Description("Milestone title")),
+		mcp.WithString("search", mcp.Description("Search issues by title and description")),
+	)
+
+	listBoardsTool := mcp.NewTool("list_boards",
+		mcp.WithDescription("List the issue boards of a project or group with each board's lists and the labels, assignees or milestones they filter on"),
+		mcp.WithString("project_path", mcp.Description("Project/repo path (either project_path or group_id is required)")),
+		mcp.WithString("group_id", mcp.Description("Group ID or path to list group boards instead")),
+	)
+
+	listGroupIssuesTool := mcp.NewTool("list_group_issues",
+		mcp.WithDescription("List issues across all projects of a group, optionally filtered by state, labels and milestone"),
+		mcp.WithString("group_id", mcp.Required(), mcp.Description("Group ID or full path")),
+		mcp.WithString("state", mcp.Description("Issue state (opened/closed/all, default: opened)")),
+		mcp.WithString("labels", mcp.Description("Comma-separated list of labels the issues must have")),
+		mcp.WithString("milestone", mcp.Description("Milestone title")),
+	)
+
+	issueLinksTool := mcp.NewTool("manage_issue_links",
+		mcp.WithDescription("Manage links between issues to track dependencies: list, create, delete"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: list, create, delete")),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path of the source issue")),
+		mcp.WithNumber("issue_iid", mcp.Required(), mcp.Description("IID of the source issue")),
+		mcp.WithString("target_project_path", mcp.Description("Project/repo path of the issue to link to (create action, defaults to project_path)")),
+		mcp.WithNumber("target_issue_iid", mcp.Description("IID of the issue to link to (required for create)")),
+		mcp.WithString("link_type", mcp.Description("How the source issue relates to the target: relates_to, blocks, is_blocked_by (create action, default: relates_to)"), mcp.Enum("relates_to", "blocks", "is_blocked_by")),
+		mcp.WithNumber("link_id", mcp.Description("ID of the issue link, as shown by list (required for delete)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for create and delete actions")),
+	)
+
+	s.AddTool(issueManagementTool, mcp.NewTypedToolHandler(issueManagementHandler))
+	s.AddTool(issueStatisticsTool, mcp.NewTypedToolHandler(issueStatisticsHandler))
+	s.AddTool(dueDateReportTool, mcp.NewTypedToolHandler(dueDateReportHandler))
+	s.AddTool(listBoardsTool, mcp.NewTypedToolHandler(listBoardsHandler))
+	s.AddTool(listGroupIssuesTool, mcp.NewTypedToolHandler(listGroupIssuesHandler))
+	s.AddTool(issueLinksTool, mcp.NewTypedToolHandler(issueLinksHandler))
+}
+
+// Consolidated issue management handler
+func issueManagementHandler(ctx context.Context, request mcp.CallToolRequest, args IssueManagementArgs) (*mcp.CallToolResult, error) {
+	if args.Action != "list" && args.Action != "create" && args.IssueIID == "" {
+		return mcp.NewToolResultError(fmt.Sprintf("issue_iid is required for %s action", args.Action)), nil
+	}
+
+	switch args.Action {
+	case "list":
+		return handleListIssues(ctx, args)
+	case "get":
+		return handleGetIssue(ctx, args)
+	case "create":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating an issue."), nil
+		}
+		if args.CreateOptions.Title == "" {
+			return mcp.NewToolResultError("title is required in create_options for create action"), nil
+		}
+		return handleCreateIssue(ctx, args)
+	case "update":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with updating the issue."), nil
+		}
+		return handleUpdateIssue(ctx, args, "")
+	case "close":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with closing the issue."), nil
+		}
+		return handleUpdateIssue(ctx, args, "close")
+	case "reopen":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with reopening the issue."), nil
+		}
+		return handleUpdateIssue(ctx, args, "reopen")
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list, get, create, update, close, reopen", args.Action)), nil
+	}
+}
+
+func handleListIssues(ctx context.Context, args IssueManagementArgs) (*mcp.CallToolResult, error) {
+	state := args.ListOptions.State
+	if state == "" {
+		state = "all"
+	}
+
+	opt := &gitlab.ListProjectIssuesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+	// GitLab lists all states when the state filter is omitted
+	if state != "all" {
+		opt.State = gitlab.Ptr(state)
+	}
+	if args.ListOptions.Labels != "" {
+		opt.Labels = parseLabels(args.ListOptions.Labels)
+	}
+	if args.ListOptions.Search != "" {
+		opt.Search = gitlab.Ptr(args.ListOptions.Search)
+	}
+
+	issues, truncated, err := util.CollectPages(&opt.ListOptions, maxListedIssues, func() ([]*gitlab.Issue, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).Issues.ListProjectIssues(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Issues for project %s (state: %s):\n\n", args.ProjectPath, state))
+
+	if len(issues) == 0 {
+		result.WriteString("No issues found.\n")
+		return mcp.NewToolResultText(result.String()), nil
+	}
+
+	for _, issue := range issues {
+		result.WriteString(fmt.Sprintf("#%d: %s\n", issue.IID, issue.Title))
+		result.WriteString(fmt.Sprintf("State: %s\n", issue.State))
+		if issue.Author != nil {
+			result.WriteString(fmt.Sprintf("Author: %s\n", issue.Author.Username))
+		}
+		if len(issue.Labels) > 0 {
+			result.WriteString(fmt.Sprintf("Labels: %s\n", strings.Join(issue.Labels, ", ")))
+		}
+		if issue.DueDate != nil {
+			result.WriteString(fmt.Sprintf("Due Date: %s\n", issue.DueDate.String()))
+		}
+		result.WriteString(fmt.Sprintf("Created: %s\n", issue.CreatedAt.Format("2006-01-02 15:04:05")))
+		result.WriteString(fmt.Sprintf("URL: %s\n\n", issue.WebURL))
+	}
+	if truncated {
+		result.WriteString(fmt.Sprintf("Showing the first %d issues. Narrow the filters to see the rest.\n", maxListedIssues))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func listGroupIssuesHandler(ctx context.Context, request mcp.CallToolRequest, args ListGroupIssuesArgs) (*mcp.CallToolResult, error) {
+	state := args.State
+	if state == "" {
+		state = "opened"
+	}
+
+	opt := &gitlab.ListGroupIssuesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+	// GitLab lists all states when the state filter is omitted
+	if state != "all" {
+		opt.State = gitlab.Ptr(state)
+	}
+	if args.Labels != "" {
+		opt.Labels = parseLabels(args.Labels)
+	}
+	if args.Milestone != "" {
+		opt.Milestone = gitlab.Ptr(args.Milestone)
+	}
+
+	issues, truncated, err := util.CollectPages(&opt.ListOptions, maxListedIssues, func() ([]*gitlab.Issue, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).Issues.ListGroupIssues(args.GroupID, opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list group issues: %v", err)), nil
+	}
+
+	if len(issues) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No issues found in group %s (state: %s)\n", args.GroupID, state)), nil
+	}
+
+	result := formatIssuesResult(issues)
+	if truncated {
+		result += fmt.Sprintf("Showing the first %d issues. Narrow the filters to see the rest.\n", maxListedIssues)
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
+
+func handleGetIssue(ctx context.Context, args IssueManagementArgs) (*mcp.CallToolResult, error) {
+	issueIID, err := strconv.Atoi(args.IssueIID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid issue_iid: %v", err)), nil
+	}
+
+	issue, _, err := util.GitlabClientFromContext(ctx).Issues.GetIssue(args.ProjectPath, issueIID, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get issue: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(formatIssueDetails(issue)), nil
+}
+
+func handleCreateIssue(ctx context.Context, args IssueManagementArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.CreateIssueOptions{
+		Title: gitlab.Ptr(args.CreateOptions.Title),
+	}
+	if args.CreateOptions.Description != "" {
+		opt.Description = gitlab.Ptr(args.CreateOptions.Description)
+	}
+	if args.CreateOptions.Labels != "" {
+		opt.Labels = parseLabels(args.CreateOptions.Labels)
+	}
+	if len(args.CreateOptions.AssigneeIDs) > 0 {
+		opt.AssigneeIDs = &args.CreateOptions.AssigneeIDs
+	}
+	if args.CreateOptions.MilestoneID != 0 {
+		opt.MilestoneID = gitlab.Ptr(args.CreateOptions.MilestoneID)
+	}
+	if args.CreateOptions.DueDate != "" {
+		dueDate, err := parseDueDate(args.CreateOptions.DueDate)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		opt.DueDate = dueDate
+	}
+
+	issue, _, err := util.GitlabClientFromContext(ctx).Issues.CreateIssue(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to create issue: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText("Issue created successfully!\n\n" + formatIssueDetails(issue)), nil
+}
+
+// handleUpdateIssue applies update_options; stateEvent is set by the close and reopen actions
+func handleUpdateIssue(ctx context.Context, args IssueManagementArgs, stateEvent string) (*mcp.CallToolResult, error) {
+	issueIID, err := strconv.Atoi(args.IssueIID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid issue_iid: %v", err)), nil
+	}
+
+	opt := &gitlab.UpdateIssueOptions{}
+	if stateEvent != "" {
+		opt.StateEvent = gitlab.Ptr(stateEvent)
+	}
+	if args.UpdateOptions.Title != "" {
+		opt.Title = gitlab.Ptr(args.UpdateOptions.Title)
+	}
+	if args.UpdateOptions.Description != "" {
+		opt.Description = gitlab.Ptr(args.UpdateOptions.Description)
+	}
+	if args.UpdateOptions.Labels != "" {
+		opt.Labels = parseLabels(args.UpdateOptions.Labels)
+	}
+	if len(args.UpdateOptions.AssigneeIDs) > 0 {
+		opt.AssigneeIDs = &args.UpdateOptions.AssigneeIDs
+	}
+	if args.UpdateOptions.MilestoneID != 0 {
+		opt.MilestoneID = gitlab.Ptr(args.UpdateOptions.MilestoneID)
+	}
+	if args.UpdateOptions.DueDate != "" {
+		dueDate, err := parseDueDate(args.UpdateOptions.DueDate)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		opt.DueDate = dueDate
+	}
+
+	issue, _, err := util.GitlabClientFromContext(ctx).Issues.UpdateIssue(args.ProjectPath, issueIID, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to update issue: %v", err)), nil
+	}
+
+	var header string
+	switch stateEvent {
+	case "close":
+		header = "Issue closed successfully!\n\n"
+	case "reopen":
+		header = "Issue reopened successfully!\n\n"
+	default:
+		header = "Issue updated successfully!\n\n"
+	}
+
+	return mcp.NewToolResultText(header + formatIssueDetails(issue)), nil
+}
+
+func issueStatisticsHandler(ctx context.Context, request mcp.CallToolRequest, args IssueStatisticsArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.GetProjectIssuesStatisticsOptions{}
+
+	var filters []string
+	if args.Labels != "" {
+		opt.Labels = parseLabels(args.Labels)
+		filters = append(filters, fmt.Sprintf("labels: %s", args.Labels))
+	}
+	if args.AssigneeUsername != "" {
+		assigneeID, err := resolveUserID(ctx, args.AssigneeUsername)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		opt.AssigneeID = gitlab.Ptr(assigneeID)
+		filters = append(filters, fmt.Sprintf("assignee: %s", args.AssigneeUsername))
+	}
+	if args.Milestone != "" {
+		opt.Milestone = gitlab.Ptr(args.Milestone)
+		filters = append(filters, fmt.Sprintf("milestone: %s", args.Milestone))
+	}
+	if args.Search != "" {
+		opt.Search = gitlab.Ptr(args.Search)
+		filters = append(filters, fmt.Sprintf("search: %s", args.Search))
+	}
+
+	stats, _, err := util.GitlabClientFromContext(ctx).IssuesStatistics.GetProjectIssuesStatistics(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get issue statistics: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Issue statistics for project %s\n", args.ProjectPath))
+	if len(filters) > 0 {
+		result.WriteString(fmt.Sprintf("Filters: %s\n", strings.Join(filters, ", ")))
+	}
+	result.WriteString(fmt.Sprintf("\nOpen: %d\n", stats.Statistics.Counts.Opened))
+	result.WriteString(fmt.Sprintf("Closed: %d\n", stats.Statistics.Counts.Closed))
+	result.WriteString(fmt.Sprintf("Total: %d\n", stats.Statistics.Counts.All))
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func listBoardsHandler(ctx context.Context, request mcp.CallToolRequest, args ListBoardsArgs) (*mcp.CallToolResult, error) {
+	var result strings.Builder
+
+	if args.GroupID != "" {
+		boards, _, err := util.GitlabClientFromContext(ctx).GroupIssueBoards.ListGroupIssueBoards(args.GroupID, &gitlab.ListGroupIssueBoardsOptions{
+			PerPage: util.DefaultPerPage(100),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to list group boards: %v", err)), nil
+		}
+
+		result.WriteString(fmt.Sprintf("Issue boards for group %s (%d):\n\n", args.GroupID, len(boards)))
+		for _, board := range boards {
+			var labels []string
+			for _, label := range board.Labels {
+				labels = append(labels, label.Name)
+			}
+			result.WriteString(formatBoard(board.ID, board.Name, board.Milestone, labels, board.Lists))
+			result.WriteString("\n")
+		}
+	} else {
+		boards, _, err := util.GitlabClientFromContext(ctx).Boards.ListIssueBoards(args.ProjectPath, &gitlab.ListIssueBoardsOptions{
+			PerPage: util.DefaultPerPage(100),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to list project boards: %v", err)), nil
+		}
+
+		result.WriteString(fmt.Sprintf("Issue boards for project %s (%d):\n\n", args.ProjectPath, len(boards)))
+		for _, board := range boards {
+			var labels []string
+			for _, label := range board.Labels {
+				labels = append(labels, label.Name)
+			}
+			result.WriteString(formatBoard(board.ID, board.Name, board.Milestone, labels, board.Lists))
+			result.WriteString("\n")
+		}
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// formatBoard renders a project or group board; both share the list structure
+func formatBoard(id int, name string, milestone *gitlab.Milestone, labels []string, lists []*gitlab.BoardList) string {
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Board: %s (ID: %d)\n", name, id))
+
+	// Board scope narrows which issues appear on every list
+	if milestone != nil {
+		result.WriteString(fmt.Sprintf("Scoped to milestone: %s\n", milestone.Title))
+	}
+	if len(labels) > 0 {
+		result.WriteString(fmt.Sprintf("Scoped to labels: %s\n", strings.Join(labels, ", ")))
+	}
+
+	result.WriteString("Lists: Open")
+	for _, list := range lists {
+		switch {
+		case list.Label != nil:
+			result.WriteString(fmt.Sprintf(" → %s", list.Label.Name))
+		case list.Assignee != nil:
+			result.WriteString(fmt.Sprintf(" → @%s", list.Assignee.Username))
+		case list.Milestone != nil:
+			result.WriteString(fmt.Sprintf(" → milestone %s", list.Milestone.Title))
+		default:
+			result.WriteString(fmt.Sprintf(" → list %d", list.ID))
+		}
+		if list.MaxIssueCount > 0 {
+			result.WriteString(fmt.Sprintf(" (WIP limit %d)", list.MaxIssueCount))
+		}
+	}
+	result.WriteString(" → Closed\n")
+	return result.String()
+}
+
+// dueItem is an issue or merge request with the due date it is judged by
+type dueItem struct {
+	ref       string
+	title     string
+	assignees []string
+	dueDate   time.Time
+	url       string
+}
+
+func dueDateReportHandler(ctx context.Context, request mcp.CallToolRequest, args DueDateReportArgs) (*mcp.CallToolResult, error) {
+	if (args.ProjectPath == "") == (args.GroupID == "") {
+		return mcp.NewToolResultError("exactly one of project_path or group_id is required"), nil
+	}
+
+	days := defaultDueSoonDays
+	if args.Days != nil {
+		days = *args.Days
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	horizon := today.AddDate(0, 0, days)
+
+	issues, issuesTruncated, err := listOpenIssuesByDueDate(ctx, args.ProjectPath, args.GroupID, horizon)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %v", err)), nil
+	}
+
+	var items []dueItem
+	for _, issue := range issues {
+		if issue.DueDate == nil {
+			continue
+		}
+		item := dueItem{
+			ref:     fmt.Sprintf("#%d", issue.IID),
+			title:   issue.Title,
+			dueDate: time.Time(*issue.DueDate),
+			url:     issue.WebURL,
+		}
+		if issue.References != nil && args.GroupID != "" {
+			item.ref = issue.References.Full
+		}
+		for _, assignee := range issue.Assignees {
+			item.assignees = append(item.assignees, assignee.Username)
+		}
+		items = append(items, item)
+	}
+
+	mrsTruncated := false
+	if args.IncludeMergeRequests {
+		mrs, truncated, err := listOpenMergeRequests(ctx, args.ProjectPath, args.GroupID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to list merge requests: %v", err)), nil
+		}
+		mrsTruncated = truncated
+		for _, mr := range mrs {
+			if mr.Milestone == nil || mr.Milestone.DueDate == nil {
+				continue
+			}
+			item := dueItem{
+				ref:     fmt.Sprintf("!%d", mr.IID),
+				title:   fmt.Sprintf("%s (milestone: %s)", mr.Title, mr.Milestone.Title),
+				dueDate: time.Time(*mr.Milestone.DueDate),
+				url:     mr.WebURL,
+			}
+			if mr.References != nil && args.GroupID != "" {
+				item.ref = mr.References.Full
+			}
+			for _, assignee := range mr.Assignees {
+				item.assignees = append(item.assignees, assignee.Username)
+			}
+			items = append(items, item)
+		}
+	}
+
+	var overdue, dueSoon []dueItem
+	for _, item := range items {
+		switch {
+		case item.dueDate.Before(today):
+			overdue = append(overdue, item)
+		case !item.dueDate.After(horizon):
+			dueSoon = append(dueSoon, item)
+		}
+	}
+
+	scope := args.ProjectPath
+	if scope == "" {
+		scope = "group " + args.GroupID
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Due date report for %s (as of %s, window: %d days)\n\n", scope, today.Format("2006-01-02"), days))
+
+	result.WriteString(fmt.Sprintf("🔴 Overdue (%d):\n", len(overdue)))
+	for _, item := range overdue {
+		result.WriteString(formatDueItem(item, fmt.Sprintf("%d days overdue", int(today.Sub(item.dueDate).Hours()/24))))
+	}
+
+	result.WriteString(fmt.Sprintf("\n🟡 Due within %d days (%d):\n", days, len(dueSoon)))
+	for _, item := range dueSoon {
+		daysLeft := int(item.dueDate.Sub(today).Hours() / 24)
+		note := fmt.Sprintf("due in %d days", daysLeft)
+		if daysLeft == 0 {
+			note = "due today"
+		}
+		result.WriteString(formatDueItem(item, note))
+	}
+
+	if len(overdue) == 0 && len(dueSoon) == 0 {
+		result.WriteString("\n✅ Nothing is overdue or due soon.\n")
+	}
+	if issuesTruncated {
+		result.WriteString(fmt.Sprintf("\n⚠️ Only the first %d open issues by due date were checked, more may be overdue or due soon.\n", maxListedIssues))
+	}
+	if mrsTruncated {
+		result.WriteString(fmt.Sprintf("\n⚠️ Only the first %d open merge requests with a milestone were checked.\n", maxListedMergeRequests))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func formatDueItem(item dueItem, note string) string {
+	assignees := "unassigned"
+	if len(item.assignees) > 0 {
+		assignees = strings.Join(item.assignees, ", ")
+	}
+	return fmt.Sprintf("- %s %s\n  Due: %s (%s), Assignees: %s\n  %s\n",
+		item.ref, item.title, item.dueDate.Format("2006-01-02"), note, assignees, item.url)
+}
+
+// listOpenIssuesByDueDate pages through open issues in due date order until
+// one is due after horizon, up to maxListedIssues, reporting whether more exist.
+func listOpenIssuesByDueDate(ctx context.Context, projectPath, groupID string, horizon time.Time) ([]*gitlab.Issue, bool, error) {
+	opt := gitlab.ListOptions{PerPage: util.DefaultPerPage(100)}
+
+	return util.CollectPages(&opt, maxListedIssues, func() ([]*gitlab.Issue, *gitlab.Response, error) {
+		var issues []*gitlab.Issue
+		var resp *gitlab.Response
+		var err error
+		if projectPath != "" {
+			issues, resp, err = util.GitlabClientFromContext(ctx).Issues.ListProjectIssues(projectPath, &gitlab.ListProjectIssuesOptions{
+				State:       gitlab.Ptr("opened"),
+				OrderBy:     gitlab.Ptr("due_date"),
+				Sort:        gitlab.Ptr("asc"),
+				ListOptions: opt,
+			}, gitlab.WithContext(ctx))
+		} else {
+			issues, resp, err = util.GitlabClientFromContext(ctx).Issues.ListGroupIssues(groupID, &gitlab.ListGroupIssuesOptions{
+				State:       gitlab.Ptr("opened"),
+				OrderBy:     gitlab.Ptr("due_date"),
+				Sort:        gitlab.Ptr("asc"),
+				ListOptions: opt,
+			}, gitlab.WithContext(ctx))
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Issues without a due date sort last, so either case ends the scan;
+		// hiding the next page tells CollectPages there is nothing more to read
+		if len(issues) > 0 {
+			last := issues[len(issues)-1]
+			if last.DueDate == nil || time.Time(*last.DueDate).After(horizon) {
+				stop := *resp
+				stop.NextPage = 0
+				resp = &stop
+			}
+		}
+		return issues, resp, nil
+	})
+}
+
+// listOpenMergeRequests pages through open merge requests that have a
+// milestone, up to maxListedMergeRequests, reporting whether more exist.
+func listOpenMergeRequests(ctx context.Context, projectPath, groupID string) ([]*gitlab.BasicMergeRequest, bool, error) {
+	if projectPath != "" {
+		opt := &gitlab.ListProjectMergeRequestsOptions{
+			State:       gitlab.Ptr("opened"),
+			Milestone:   gitlab.Ptr("Any"),
+			ListOptions: gitlab.ListOptions{PerPage: util.DefaultPerPage(100)},
+		}
+		return util.CollectPages(&opt.ListOptions, maxListedMergeRequests, func() ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+			return util.GitlabClientFromContext(ctx).MergeRequests.ListProjectMergeRequests(projectPath, opt, gitlab.WithContext(ctx))
+		})
+	}
+
+	opt := &gitlab.ListGroupMergeRequestsOptions{
+		State:       gitlab.Ptr("opened"),
+		Milestone:   gitlab.Ptr("Any"),
+		ListOptions: gitlab.ListOptions{PerPage: util.DefaultPerPage(100)},
+	}
+	return util.CollectPages(&opt.ListOptions, maxListedMergeRequests, func() ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).MergeRequests.ListGroupMergeRequests(groupID, opt, gitlab.WithContext(ctx))
+	})
+}
+
+// Helper function to format issue details
+func formatIssueDetails(issue *gitlab.Issue) string {
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Issue #%d: %s\n", issue.IID, issue.Title))
+	result.WriteString(fmt.Sprintf("State: %s\n", issue.State))
+	if issue.Author != nil {
+		result.WriteString(fmt.Sprintf("Author: %s\n", issue.Author.Username))
+	}
+	if len(issue.Assignees) > 0 {
+		var assignees []string
+		for _, assignee := range issue.Assignees {
+			assignees = append(assignees, assignee.Username)
+		}
+		result.WriteString(fmt.Sprintf("Assignees: %s\n", strings.Join(assignees, ", ")))
+	}
+	if len(issue.Labels) > 0 {
+		result.WriteString(fmt.Sprintf("Labels: %s\n", strings.Join(issue.Labels, ", ")))
+	}
+	if issue.Milestone != nil {
+		result.WriteString(fmt.Sprintf("Milestone: %s\n", issue.Milestone.Title))
+	}
+	if issue.DueDate != nil {
+		result.WriteString(fmt.Sprintf("Due Date: %s\n", issue.DueDate.String()))
+	}
+	result.WriteString(fmt.Sprintf("Subscribed: %v\n", issue.Subscribed))
+	result.WriteString(fmt.Sprintf("Comments: %d\n", issue.UserNotesCount))
+	if issue.CreatedAt != nil {
+		result.WriteString(fmt.Sprintf("Created: %s\n", issue.CreatedAt.Format("2006-01-02 15:04:05")))
+	}
+	if issue.ClosedAt != nil {
+		result.WriteString(fmt.Sprintf("Closed: %s\n", issue.ClosedAt.Format("2006-01-02 15:04:05")))
+	}
+	result.WriteString(fmt.Sprintf("URL: %s\n", issue.WebURL))
+
+	if issue.Description != "" {
+		result.WriteString("\nDescription:\n")
+		result.WriteString(issue.Description)
+		result.WriteString("\n")
+	}
+
+	return result.String()
+}
+
+// parseLabels converts a comma-separated label string into GitLab label options
+func parseLabels(labels string) *gitlab.LabelOptions {
+	var parsed gitlab.LabelOptions
+	for _, label := range strings.Split(labels, ",") {
+		if label = strings.TrimSpace(label); label != "" {
+			parsed = append(parsed, label)
+		}
+	}
+	return &parsed
+}
+
+func parseDueDate(value string) (*gitlab.ISOTime, error) {
+	dueDate, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid due_date: %v", err)
+	}
+	return gitlab.Ptr(gitlab.ISOTime(dueDate)), nil
+}
+
+func issueLinksHandler(ctx context.Context, request mcp.CallToolRequest, args IssueLinksArgs) (*mcp.CallToolResult, error) {
+	switch args.Action {
+	case "list":
+		return listIssueLinks(ctx, args.ProjectPath, args.IssueIID)
+	case "create":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with linking the issues."), nil
+		}
+		if args.TargetIssueIID == 0 {
+			return mcp.NewToolResultError("target_issue_iid is required for create action"), nil
+		}
+		return createIssueLink(ctx, args)
+	case "delete":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with removing the issue link."), nil
+		}
+		if args.LinkID == 0 {
+			return mcp.NewToolResultError("link_id is required for delete action"), nil
+		}
+
+		_, _, err := util.GitlabClientFromContext(ctx).IssueLinks.DeleteIssueLink(args.ProjectPath, args.IssueIID, args.LinkID, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to delete issue link: %v", err)), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("Link %d removed from issue #%d\n", args.LinkID, args.IssueIID)), nil
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("invalid action: %s. Valid actions are: list, create, delete", args.Action)), nil
+	}
+}
+
+func listIssueLinks(ctx context.Context, projectPath string, issueIID int) (*mcp.CallToolResult, error) {
+	relations, _, err := util.GitlabClientFromContext(ctx).IssueLinks.ListIssueRelations(projectPath, issueIID, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list issue links: %v", err)), nil
+	}
+
+	if len(relations) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("Issue #%d has no linked issues\n", issueIID)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Linked issues of #%d:\n\n", issueIID))
+	for _, relation := range relations {
+		reference := fmt.Sprintf("#%d", relation.IID)
+		if relation.References != nil {
+			reference = relation.References.Full
+		}
+		result.WriteString(fmt.Sprintf("Link ID: %d\n", relation.IssueLinkID))
+		result.WriteString(fmt.Sprintf("Type: %s\n", relation.LinkType))
+		result.WriteString(fmt.Sprintf("Issue: %s %s\n", reference, relation.Title))
+		result.WriteString(fmt.Sprintf("State: %s\n", relation.State))
+		result.WriteString(fmt.Sprintf("URL: %s\n\n", relation.WebURL))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func createIssueLink(ctx context.Context, args IssueLinksArgs) (*mcp.CallToolResult, error) {
+	targetProject := args.TargetProjectPath
+	if targetProject == "" {
+		targetProject = args.ProjectPath
+	}
+	linkType := args.LinkType
+	if linkType == "" {
+		linkType = "relates_to"
+	}
+
+	link, _, err := util.GitlabClientFromContext(ctx).IssueLinks.CreateIssueLink(args.ProjectPath, args.IssueIID, &gitlab.CreateIssueLinkOptions{
+		TargetProjectID: gitlab.Ptr(targetProject),
+		TargetIssueIID:  gitlab.Ptr(strconv.Itoa(args.TargetIssueIID)),
+		LinkType:        gitlab.Ptr(linkType),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to link issues: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString("Issues linked successfully!\n\n")
+	result.WriteString(fmt.Sprintf("#%d %s %s#%d\n", link.SourceIssue.IID, strings.ReplaceAll(link.LinkType, "_", " "), targetProject, link.TargetIssue.IID))
+	result.WriteString(fmt.Sprintf("Source: %s\n", link.SourceIssue.WebURL))
+	result.WriteString(fmt.Sprintf("Target: %s\n", link.TargetIssue.WebURL))
+
+	return mcp.NewToolResultText(result.String()), nil
+}
@@ -0,0 +1,111 @@
+package tools
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestDueDateReportDaysZeroAndMergeRequestPages(t *testing.T) {
+	today := time.Now().UTC().Format("2006-01-02")
+	nextWeek := time.Now().UTC().AddDate(0, 0, 5).Format("2006-01-02")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/issues", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, []map[string]any{
+			{"id": 1, "iid": 1, "title": "Due today", "due_date": today},
+			{"id": 2, "iid": 2, "title": "Due next week", "due_date": nextWeek},
+		})
+	})
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("milestone") != "Any" {
+			t.Errorf("merge requests not filtered to those with a milestone: %s", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, []map[string]any{
+				{"iid": 9, "title": "Late page MR", "created_at": "2025-01-01T10:00:00Z", "milestone": map[string]any{"title": "v1", "due_date": today}},
+			})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{})
+	})
+
+	result, err := dueDateReportHandler(newTestContext(t, mux), mcp.CallToolRequest{}, DueDateReportArgs{
+		ProjectPath:          "group/project",
+		Days:                 gitlab.Ptr(0),
+		IncludeMergeRequests: true,
+	})
+	text := resultText(t, result, err)
+
+	if !strings.Contains(text, "window: 0 days") || strings.Contains(text, "Due next week") {
+		t.Errorf("days=0 fell back to the default window:\n%s", text)
+	}
+	for _, want := range []string{"#1 Due today", "!9 Late page MR"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("result missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestListIssuesReadsEveryPage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/issues", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, []map[string]any{{"id": 2, "iid": 2, "title": "Second page issue", "state": "opened", "created_at": "2025-01-01T10:00:00Z"}})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{{"id": 1, "iid": 1, "title": "First page issue", "state": "opened", "created_at": "2025-01-01T10:00:00Z"}})
+	})
+
+	result, err := handleListIssues(newTestContext(t, mux), IssueManagementArgs{
+		Action:      "list",
+		ProjectPath: "group/project",
+	})
+	text := resultText(t, result, err)
+
+	for _, want := range []string{"#1: First page issue", "#2: Second page issue"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("result missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "Showing the first") {
+		t.Errorf("result should not be marked truncated:\n%s", text)
+	}
+}
+
+func TestDueDateReportCapsOverdueIssues(t *testing.T) {
+	yesterday := time.Now().UTC().AddDate(0, 0, -1).Format("2006-01-02")
+
+	var pages int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/issues", func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		issues := make([]map[string]any, 0, 100)
+		for i := range 100 {
+			id := pages*100 + i
+			issues = append(issues, map[string]any{"id": id, "iid": id, "title": "Overdue", "due_date": yesterday})
+		}
+		w.Header().Set("X-Next-Page", strconv.Itoa(pages+1))
+		writeJSON(t, w, issues)
+	})
+
+	result, err := dueDateReportHandler(newTestContext(t, mux), mcp.CallToolRequest{}, DueDateReportArgs{
+		ProjectPath: "group/project",
+	})
+	text := resultText(t, result, err)
+
+	if want := maxListedIssues / 100; pages != want {
+		t.Errorf("fetched %d pages, want %d", pages, want)
+	}
+	if !strings.Contains(text, fmt.Sprintf("Only the first %d open issues", maxListedIssues)) {
+		t.Errorf("result should be marked truncated:\n%s", text[len(text)-300:])
+	}
+}
@@ -0,0 +1,162 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/gitlab-mcp/util"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+type ListGroupIterationsArgs struct {
+	GroupID          string `json:"group_id" validate:"required,min=1"`
+	State            string `json:"state" validate:"omitempty,oneof=opened upcoming current closed all"`
+	Search           string `json:"search" validate:"omitempty,min=1,max=100"`
+	IncludeAncestors bool   `json:"include_ancestors"`
+}
+
+type SetIssueIterationArgs struct {
+	ProjectPath string  `json:"project_path" validate:"required,min=1"`
+	IssueIID    float64 `json:"issue_iid" validate:"required,min=1"`
+	IterationID float64 `json:"iteration_id" validate:"omitempty,min=0"`
+	Confirmed   bool    `json:"confirmed,omitempty"`
+}
+
+func RegisterIterationTools(s *server.MCPServer) {
+	listGroupIterationsTool := mcp.NewTool("list_group_iterations",
+		mcp.WithDescription("List iterations (sprints) of a GitLab group"),
+		mcp.WithString("group_id", mcp.Required(), mcp.Description("GitLab group ID or path")),
+		mcp.WithString("state", mcp.Description("Iteration state (opened, upcoming, current, closed, all)")),
+		mcp.WithString("search", mcp.Description("Search iterations by title")),
+		mcp.WithBoolean("include_ancestors", mcp.Description("Include iterations from parent groups")),
+	)
+	s.AddTool(listGroupIterationsTool, mcp.NewTypedToolHandler(listGroupIterationsHandler))
+
+	setIssueIterationTool := mcp.NewTool("set_issue_iteration",
+		mcp.WithDescription("Assign an issue to an iteration, or remove it from its iteration"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
+		mcp.WithNumber("issue_iid", mcp.Required(), mcp.Description("Issue IID")),
+		mcp.WithNumber("iteration_id", mcp.Description("Iteration ID to assign (omit or 0 to remove the current iteration)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required to change the issue")),
+	)
+	s.AddTool(setIssueIterationTool, mcp.NewTypedToolHandler(setIssueIterationHandler))
+}
+
+func listGroupIterationsHandler(ctx context.Context, request mcp.CallToolRequest, args ListGroupIterationsArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.ListGroupIterationsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+
+	if args.State != "" {
+		opt.State = gitlab.Ptr(args.State)
+	}
+
+	if args.Search != "" {
+		opt.Search = gitlab.Ptr(args.Search)
+	}
+
+	if args.IncludeAncestors {
+		opt.IncludeAncestors = gitlab.Ptr(true)
+	}
+
+	iterations, err := util.AllPages(&opt.ListOptions, func() ([]*gitlab.GroupIteration, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).GroupIterations.ListGroupIterations(args.GroupID, opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list group iterations: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Iterations for group %s:\n\n", args.GroupID))
+
+	for _, iteration := range iterations {
+		title := iteration.Title
+		if title == "" {
+			title = fmt.Sprintf("Iteration %d", iteration.Sequence)
+		}
+		result.WriteString(fmt.Sprintf("Iteration: %s\n", title))
+		result.WriteString(fmt.Sprintf("ID: %d\n", iteration.ID))
+		result.WriteString(fmt.Sprintf("State: %s\n", getIterationStateString(iteration.State)))
+		if iteration.StartDate != nil {
+			result.WriteString(fmt.Sprintf("Start Date: %s\n", iteration.StartDate.String()))
+		}
+		if iteration.DueDate != nil {
+			result.WriteString(fmt.Sprintf("Due Date: %s\n", iteration.DueDate.String()))
+		}
+		if iteration.WebURL != "" {
+			result.WriteString(fmt.Sprintf("Web URL: %s\n", iteration.WebURL))
+		}
+		result.WriteString("\n")
+	}
+
+	if len(iterations) == 0 {
+		result.WriteString("No iterations found matching the criteria.\n")
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func setIssueIterationHandler(ctx context.Context, request mcp.CallToolRequest, args SetIssueIterationArgs) (*mcp.CallToolResult, error) {
+	if !args.Confirmed {
+		return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with changing the issue iteration."), nil
+	}
+
+	issueIID := int(args.IssueIID)
+	iterationID := int(args.IterationID)
+
+	// The issues API has no iteration field, so use the /iteration quick action
+	body := "/remove_iteration"
+	if iterationID > 0 {
+		body = fmt.Sprintf("/iteration *iteration:%d", iterationID)
+	}
+
+	_, _, err := util.GitlabClientFromContext(ctx).Notes.CreateIssueNote(args.ProjectPath, issueIID, &gitlab.CreateIssueNoteOptions{
+		Body: gitlab.Ptr(body),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to set issue iteration: %v", err)), nil
+	}
+
+	issue, _, err := util.GitlabClientFromContext(ctx).Issues.GetIssue(args.ProjectPath, issueIID, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get issue: %v", err)), nil
+	}
+
+	// GitLab silently drops a quick action it can't apply, so confirm the change took
+	switch {
+	case iterationID > 0 && (issue.Iteration == nil || issue.Iteration.ID != iterationID):
+		return mcp.NewToolResultError(fmt.Sprintf("iteration %d was not assigned to issue #%d; check that the iteration exists in the project's group and is not closed", iterationID, issueIID)), nil
+	case iterationID == 0 && issue.Iteration != nil:
+		return mcp.NewToolResultError(fmt.Sprintf("iteration %d was not removed from issue #%d", issue.Iteration.ID, issueIID)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Issue #%d: %s\n", issue.IID, issue.Title))
+	if issue.Iteration != nil {
+		result.WriteString(fmt.Sprintf("Iteration: %s (ID: %d)\n", issue.Iteration.Title, issue.Iteration.ID))
+	} else {
+		result.WriteString("Iteration: none\n")
+	}
+	result.WriteString(fmt.Sprintf("URL: %s\n", issue.WebURL))
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// Helper function to convert iteration state to string
+func getIterationStateString(state int) string {
+	switch state {
+	case 1:
+		return "upcoming"
+	case 2:
+		return "current"
+	case 3:
+		return "closed"
+	default:
+		return fmt.Sprintf("unknown (%d)", state)
+	}
+}
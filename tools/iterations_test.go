@@ -0,0 +1,32 @@
+package tools
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSetIssueIterationFailsWhenQuickActionIsDropped(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v4/projects/group%2Fproject/issues/4/notes", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"id": 1})
+	})
+	mux.HandleFunc("GET /api/v4/projects/group%2Fproject/issues/4", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"id": 40, "iid": 4, "title": "Plan sprint", "iteration": map[string]any{"id": 11, "title": "Sprint 1"}})
+	})
+
+	result, err := setIssueIterationHandler(newTestContext(t, mux), mcp.CallToolRequest{}, SetIssueIterationArgs{
+		ProjectPath: "group/project",
+		IssueIID:    4,
+		IterationID: 99,
+		Confirmed:   true,
+	})
+	if err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	if text := toolResultText(result); !result.IsError || !strings.Contains(text, "iteration 99") {
+		t.Fatalf("expected an error naming the requested iteration, got:\n%s", text)
+	}
+}
@@ -1,9 +1,17 @@
 package tools
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -22,10 +30,23 @@ type JobListArgs struct {
 type JobManageArgs struct {
 	ProjectPath string  `json:"project_path" validate:"required,min=1"`
 	JobID       float64 `json:"job_id" validate:"required,min=1"`
-	Action      string  `json:"action" validate:"required,oneof=get cancel retry play"` // "get", "cancel", "retry", "play"
+	Action      string  `json:"action" validate:"required,oneof=get cancel retry play download_artifact"` // "get", "cancel", "retry", "play", "download_artifact"
 	Confirmed   bool    `json:"confirmed,omitempty"`
+
+	// Variables passed to a manual job when it is played
+	Variables map[string]string `json:"variables,omitempty"`
+
+	// Artifact to read with download_artifact; omit to list the archive contents
+	ArtifactPath string `json:"artifact_path,omitempty" validate:"omitempty,min=1,max=500"`
+	MaxBytes     int    `json:"max_bytes,omitempty" validate:"omitempty,min=1"`
 }
 
+// Default cap on the artifact content returned by download_artifact
+const defaultArtifactMaxBytes = 100 * 1024
+
+// Listing an artifacts archive needs all of it in memory, so larger archives are refused
+const maxListedArtifactsBytes = 100 * 1024 * 1024
+
 func RegisterJobTools(s *server.MCPServer) {
 	// Consolidated job listing tool
 	jobListTool := mcp.NewTool("manage_jobs_list",
@@ -39,11 +60,14 @@ func RegisterJobTools(s *server.MCPServer) {
 
 	// Consolidated job management tool
 	jobManageTool := mcp.NewTool("manage_job_actions",
-		mcp.WithDescription("Perform actions on a specific job (get details, cancel, or retry)"),
+		mcp.WithDescription("Perform actions on a specific job (get details, cancel, retry, play a manual job, or read one of its artifacts)"),
 		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
 		mcp.WithNumber("job_id", mcp.Required(), mcp.Description("Job ID")),
-		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: 'get' (get details), 'cancel' (cancel job), 'retry' (retry job), 'play' (play manual job)")),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: 'get' (get details), 'cancel' (cancel job), 'retry' (retry job), 'play' (play manual job), 'download_artifact' (read an artifact file, or list the artifacts archive when artifact_path is omitted)")),
 		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for cancel, retry, and play actions")),
+		mcp.WithObject("variables", mcp.Description("Job variables as key-value pairs to pass when playing a manual job (play action only)")),
+		mcp.WithString("artifact_path", mcp.Description("Path of the file inside the artifacts archive, e.g. 'coverage/coverage.xml' (download_artifact action)")),
+		mcp.WithNumber("max_bytes", mcp.Description("Maximum artifact bytes to return (download_artifact action, default: 102400)")),
 	)
 	s.AddTool(jobManageTool, mcp.NewTypedToolHandler(jobManageHandler))
 }
@@ -72,13 +96,13 @@ func jobListHandler(ctx context.Context, request mcp.CallToolRequest, args JobLi
 	// Check if pipeline_id is provided to determine which API to call
 	if args.PipelineID != nil {
 		pipelineID := int(*args.PipelineID)
-		jobs, _, err = util.GitlabClient().Jobs.ListPipelineJobs(args.ProjectPath, pipelineID, opt)
+		jobs, _, err = util.GitlabClientFromContext(ctx).Jobs.ListPipelineJobs(args.ProjectPath, pipelineID, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to list pipeline jobs: %v", err)), nil
 		}
 		result.WriteString(fmt.Sprintf("Jobs for pipeline #%d in project %s:\n\n", pipelineID, args.ProjectPath))
 	} else {
-		jobs, _, err = util.GitlabClient().Jobs.ListProjectJobs(args.ProjectPath, opt)
+		jobs, _, err = util.GitlabClientFromContext(ctx).Jobs.ListProjectJobs(args.ProjectPath, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to list project jobs: %v", err)), nil
 		}
@@ -107,30 +131,39 @@ func jobManageHandler(ctx context.Context, request mcp.CallToolRequest, args Job
 
 	switch strings.ToLower(args.Action) {
 	case "get":
-		return getJobDetails(args.ProjectPath, jobID)
+		return getJobDetails(ctx, args.ProjectPath, jobID)
 	case "cancel":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with canceling the job."), nil
 		}
-		return cancelJobAction(args.ProjectPath, jobID)
+		return cancelJobAction(ctx, args.ProjectPath, jobID)
 	case "retry":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with retrying the job."), nil
 		}
-		return retryJobAction(args.ProjectPath, jobID)
+		return retryJobAction(ctx, args.ProjectPath, jobID)
 	case "play":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with playing the manual job."), nil
 		}
-		return playJobAction(args.ProjectPath, jobID)
+		return playJobAction(ctx, args.ProjectPath, jobID, args.Variables)
+	case "download_artifact":
+		maxBytes := defaultArtifactMaxBytes
+		if args.MaxBytes > 0 {
+			maxBytes = args.MaxBytes
+		}
+		if args.ArtifactPath == "" {
+			return listJobArtifacts(ctx, args.ProjectPath, jobID)
+		}
+		return downloadJobArtifact(ctx, args.ProjectPath, jobID, args.ArtifactPath, maxBytes)
 	default:
-		return mcp.NewToolResultError(fmt.Sprintf("invalid action '%s'. Valid actions are: get, cancel, retry, play", args.Action)), nil
+		return mcp.NewToolResultError(fmt.Sprintf("invalid action '%s'. Valid actions are: get, cancel, retry, play, download_artifact", args.Action)), nil
 	}
 }
 
 // Helper functions for job management actions
-func getJobDetails(projectPath string, jobID int) (*mcp.CallToolResult, error) {
-	job, _, err := util.GitlabClient().Jobs.GetJob(projectPath, jobID)
+func getJobDetails(ctx context.Context, projectPath string, jobID int) (*mcp.CallToolResult, error) {
+	job, _, err := util.GitlabClientFromContext(ctx).Jobs.GetJob(projectPath, jobID, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get job: %v", err)), nil
 	}
@@ -142,8 +175,8 @@ func getJobDetails(projectPath string, jobID int) (*mcp.CallToolResult, error) {
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func cancelJobAction(projectPath string, jobID int) (*mcp.CallToolResult, error) {
-	job, _, err := util.GitlabClient().Jobs.CancelJob(projectPath, jobID)
+func cancelJobAction(ctx context.Context, projectPath string, jobID int) (*mcp.CallToolResult, error) {
+	job, _, err := util.GitlabClientFromContext(ctx).Jobs.CancelJob(projectPath, jobID, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to cancel job: %v", err)), nil
 	}
@@ -155,8 +188,8 @@ func cancelJobAction(projectPath string, jobID int) (*mcp.CallToolResult, error)
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func retryJobAction(projectPath string, jobID int) (*mcp.CallToolResult, error) {
-	job, _, err := util.GitlabClient().Jobs.RetryJob(projectPath, jobID)
+func retryJobAction(ctx context.Context, projectPath string, jobID int) (*mcp.CallToolResult, error) {
+	job, _, err := util.GitlabClientFromContext(ctx).Jobs.RetryJob(projectPath, jobID, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to retry job: %v", err)), nil
 	}
@@ -294,15 +327,123 @@ func formatJobDetailedInfo(job *gitlab.Job) string {
 	return result.String()
 }
 
-func playJobAction(projectPath string, jobID int) (*mcp.CallToolResult, error) {
-	job, _, err := util.GitlabClient().Jobs.PlayJob(projectPath, jobID, nil)
+func playJobAction(ctx context.Context, projectPath string, jobID int, variables map[string]string) (*mcp.CallToolResult, error) {
+	var opt *gitlab.PlayJobOptions
+	if len(variables) > 0 {
+		jobVariables := make([]*gitlab.JobVariableOptions, 0, len(variables))
+		for key, value := range variables {
+			jobVariables = append(jobVariables, &gitlab.JobVariableOptions{
+				Key:   gitlab.Ptr(key),
+				Value: gitlab.Ptr(value),
+			})
+		}
+		opt = &gitlab.PlayJobOptions{JobVariablesAttributes: &jobVariables}
+	}
+
+	job, _, err := util.GitlabClientFromContext(ctx).Jobs.PlayJob(projectPath, jobID, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to play job: %v", err)), nil
 	}
 
 	var result strings.Builder
 	result.WriteString(fmt.Sprintf("Manual job #%d has been played successfully!\n\n", job.ID))
+	if len(variables) > 0 {
+		result.WriteString(fmt.Sprintf("Variables: %d passed to the job\n", len(variables)))
+	}
 	result.WriteString(formatJobInfo(job))
 
 	return mcp.NewToolResultText(result.String()), nil
 }
+
+// listJobArtifacts lists the files in a job's artifacts archive so one can be picked for download
+func listJobArtifacts(ctx context.Context, projectPath string, jobID int) (*mcp.CallToolResult, error) {
+	downloadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	buf := &util.LimitedBuffer{Limit: maxListedArtifactsBytes, OnLimit: cancel}
+	if err := streamJobArtifacts(downloadCtx, projectPath, jobID, "", buf); err != nil {
+		if errors.Is(err, util.ErrLimitExceeded) {
+			return mcp.NewToolResultError(fmt.Sprintf("artifacts archive of job #%d is larger than %d MB, too large to list", jobID, maxListedArtifactsBytes/1024/1024)), nil
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("failed to download artifacts for job #%d: %v", jobID, err)), nil
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to read artifacts for job #%d: %v", jobID, err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Artifacts of job #%d:\n\n", jobID))
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		result.WriteString(fmt.Sprintf("- %s (%d bytes)\n", file.Name, file.UncompressedSize64))
+	}
+	result.WriteString("\nSet artifact_path to one of these files to read it.\n")
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// downloadJobArtifact returns a single artifact file as text, or base64 for binary content
+func downloadJobArtifact(ctx context.Context, projectPath string, jobID int, artifactPath string, maxBytes int) (*mcp.CallToolResult, error) {
+	// Stop reading at max_bytes rather than buffering the whole file
+	downloadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	buf := &util.LimitedBuffer{Limit: int64(maxBytes), OnLimit: cancel}
+	err := streamJobArtifacts(downloadCtx, projectPath, jobID, artifactPath, buf)
+	truncated := errors.Is(err, util.ErrLimitExceeded)
+	if err != nil && !truncated {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to download artifact %s from job #%d: %v", artifactPath, jobID, err)), nil
+	}
+
+	var result strings.Builder
+	content := buf.Bytes()
+	text := content
+	if truncated {
+		result.WriteString(fmt.Sprintf("Artifact %s from job #%d:\n", artifactPath, jobID))
+		result.WriteString(fmt.Sprintf("⚠️ Truncated: showing the first %d bytes, raise max_bytes to read more\n", len(content)))
+
+		// The cut can split a multi-byte rune at the end of otherwise valid text
+		for i := 1; i < utf8.UTFMax && len(text) > 0 && !utf8.Valid(text); i++ {
+			text = text[:len(text)-1]
+		}
+	} else {
+		result.WriteString(fmt.Sprintf("Artifact %s from job #%d (%d bytes):\n", artifactPath, jobID, len(content)))
+	}
+	if utf8.Valid(text) {
+		result.WriteString("\n")
+		result.Write(text)
+		result.WriteString("\n")
+	} else {
+		result.WriteString("Binary content (base64):\n\n")
+		result.WriteString(base64.StdEncoding.EncodeToString(content))
+		result.WriteString("\n")
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// streamJobArtifacts writes a job's artifacts archive to w, or only the file
+// at artifactPath inside it when set. The client's own download methods buffer
+// the whole body, which defeats a size cap.
+func streamJobArtifacts(ctx context.Context, projectPath string, jobID int, artifactPath string, w io.Writer) error {
+	u := fmt.Sprintf("projects/%s/jobs/%d/artifacts", gitlab.PathEscape(projectPath), jobID)
+	if artifactPath != "" {
+		// Escape each segment so characters like ? or # stay part of the path
+		for _, segment := range strings.Split(artifactPath, "/") {
+			if segment == "" || segment == "." || segment == ".." {
+				return fmt.Errorf("invalid artifact path %q", artifactPath)
+			}
+			u += "/" + url.PathEscape(segment)
+		}
+	}
+
+	client := util.GitlabClientFromContext(ctx)
+	req, err := client.NewRequest(http.MethodGet, u, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(req, w)
+	return err
+}
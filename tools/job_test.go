@@ -0,0 +1,66 @@
+package tools
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDownloadJobArtifactStopsAtMaxBytes(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/jobs/7/artifacts/coverage/report.txt", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(strings.Repeat("line\n", 1000)))
+	})
+
+	result, err := downloadJobArtifact(newTestContext(t, mux), "group/project", 7, "coverage/report.txt", 20)
+	text := resultText(t, result, err)
+
+	if !strings.Contains(text, "⚠️ Truncated: showing the first 20 bytes") {
+		t.Errorf("result doesn't report truncation at max_bytes:\n%s", text)
+	}
+	if strings.Count(text, "line") != 4 {
+		t.Errorf("result holds more than max_bytes of the artifact:\n%s", text)
+	}
+}
+
+func TestDownloadJobArtifactReturnsSmallFilesWhole(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/jobs/7/artifacts/report.txt", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("all good\n"))
+	})
+
+	result, err := downloadJobArtifact(newTestContext(t, mux), "group/project", 7, "report.txt", 20)
+	text := resultText(t, result, err)
+
+	if !strings.Contains(text, "(9 bytes)") || strings.Contains(text, "Truncated") {
+		t.Errorf("small artifact not returned whole:\n%s", text)
+	}
+}
+
+func TestDownloadJobArtifactEscapesPath(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.EscapedPath(), "/api/v4/projects/group%2Fproject/jobs/7/artifacts/reports/a%3Fb%23c.txt"; got != want {
+			t.Errorf("requested %s, want %s", got, want)
+		}
+		w.Write([]byte("ok\n"))
+	})
+
+	result, err := downloadJobArtifact(newTestContext(t, mux), "group/project", 7, "reports/a?b#c.txt", 20)
+	resultText(t, result, err)
+}
+
+func TestDownloadJobArtifactRejectsDotSegments(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL.EscapedPath())
+	})
+
+	result, err := downloadJobArtifact(newTestContext(t, mux), "group/project", 7, "../../8/artifacts/secret.txt", 20)
+	if err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	if !result.IsError {
+		t.Fatalf("expected an error result for a path with .. segments, got:\n%s", toolResultText(result))
+	}
+}
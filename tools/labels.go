@@ -0,0 +1,276 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/gitlab-mcp/util"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Consolidated label management arguments with action-based routing
+type LabelManagementArgs struct {
+	Action      string `json:"action" validate:"required,oneof=list create update delete"`
+	ProjectPath string `json:"project_path,omitempty" validate:"required_without=GroupID,omitempty,min=1"`
+	GroupID     string `json:"group_id,omitempty" validate:"required_without=ProjectPath,omitempty,min=1"`
+	Name        string `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	Confirmed   bool   `json:"confirmed,omitempty"`
+
+	// List action options
+	ListOptions struct {
+		Search                string `json:"search,omitempty" validate:"omitempty,min=1,max=200"`
+		IncludeAncestorGroups bool   `json:"include_ancestor_groups,omitempty"`
+	} `json:"list_options,omitempty"`
+
+	// Create and update action options
+	LabelOptions struct {
+		NewName     string `json:"new_name,omitempty" validate:"omitempty,min=1,max=255"`
+		Color       string `json:"color,omitempty" validate:"omitempty,min=1,max=50"`
+		Description string `json:"description,omitempty" validate:"omitempty,max=500"`
+	} `json:"label_options,omitempty"`
+}
+
+func RegisterLabelTools(s *server.MCPServer) {
+	labelManagementTool := mcp.NewTool("manage_labels",
+		mcp.WithDescription("Manage project or group labels: list (with color, text color and subscription details), create, update, delete. Pass either project_path for project labels or group_id for group labels, not both."),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: list, create, update, delete")),
+		mcp.WithString("project_path", mcp.Description("Project/repo path (required unless group_id is set)")),
+		mcp.WithString("group_id", mcp.Description("Group ID or path, to manage group labels instead of project labels")),
+		mcp.WithString("name", mcp.Description("Label name (required for: create, update, delete)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for create, update and delete actions")),
+
+		// List options
+		mcp.WithObject("list_options",
+			mcp.Description("Options for list action"),
+			mcp.Properties(map[string]any{
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Filter labels by name",
+				},
+				"include_ancestor_groups": map[string]any{
+					"type":        "boolean",
+					"description": "Include labels inherited from ancestor groups",
+				},
+			}),
+		),
+
+		// Create/update options
+		mcp.WithObject("label_options",
+			mcp.Description("Options for create and update actions"),
+			mcp.Properties(map[string]any{
+				"new_name": map[string]any{
+					"type":        "string",
+					"description": "New label name (update action)",
+				},
+				"color": map[string]any{
+					"type":        "string",
+					"description": "Label color as a hex code like #FF0000 or a CSS color name (required for create)",
+				},
+				"description": map[string]any{
+					"type":        "string",
+					"description": "Label description",
+				},
+			}),
+		),
+	)
+
+	s.AddTool(labelManagementTool, mcp.NewTypedToolHandler(labelManagementHandler))
+}
+
+// Consolidated label management handler
+func labelManagementHandler(ctx context.Context, request mcp.CallToolRequest, args LabelManagementArgs) (*mcp.CallToolResult, error) {
+	if args.ProjectPath == "" && args.GroupID == "" {
+		return mcp.NewToolResultError("project_path or group_id is required"), nil
+	}
+	if args.ProjectPath != "" && args.GroupID != "" {
+		return mcp.NewToolResultError("pass either project_path or group_id, not both"), nil
+	}
+	if args.Action != "list" {
+		if !args.Confirmed {
+			return mcp.NewToolResultError(fmt.Sprintf("This operation requires confirmation. Please set 'confirmed: true' to proceed with the label %s.", args.Action)), nil
+		}
+		if args.Name == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("name is required for %s action", args.Action)), nil
+		}
+	}
+
+	switch args.Action {
+	case "list":
+		return handleListLabels(ctx, args)
+	case "create":
+		if args.LabelOptions.Color == "" {
+			return mcp.NewToolResultError("color is required for create action"), nil
+		}
+		return handleCreateLabel(ctx, args)
+	case "update":
+		if args.LabelOptions.NewName == "" && args.LabelOptions.Color == "" && args.LabelOptions.Description == "" {
+			return mcp.NewToolResultError("at least one of new_name, color or description is required for update action"), nil
+		}
+		return handleUpdateLabel(ctx, args)
+	case "delete":
+		return handleDeleteLabel(ctx, args)
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list, create, update, delete", args.Action)), nil
+	}
+}
+
+// labelScope describes where labels live, for result messages
+func labelScope(args LabelManagementArgs) string {
+	if args.GroupID != "" {
+		return fmt.Sprintf("group %s", args.GroupID)
+	}
+	return fmt.Sprintf("project %s", args.ProjectPath)
+}
+
+// Handle list labels action
+func handleListLabels(ctx context.Context, args LabelManagementArgs) (*mcp.CallToolResult, error) {
+	var labels []*gitlab.Label
+	if args.GroupID != "" {
+		opt := &gitlab.ListGroupLabelsOptions{
+			WithCounts: gitlab.Ptr(true),
+			ListOptions: gitlab.ListOptions{
+				PerPage: util.DefaultPerPage(100),
+			},
+		}
+		if args.ListOptions.Search != "" {
+			opt.Search = gitlab.Ptr(args.ListOptions.Search)
+		}
+		if args.ListOptions.IncludeAncestorGroups {
+			opt.IncludeAncestorGroups = gitlab.Ptr(true)
+		}
+
+		groupLabels, _, err := util.GitlabClientFromContext(ctx).GroupLabels.ListGroupLabels(args.GroupID, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to list group labels: %v", err)), nil
+		}
+		for _, label := range groupLabels {
+			labels = append(labels, (*gitlab.Label)(label))
+		}
+	} else {
+		opt := &gitlab.ListLabelsOptions{
+			WithCounts: gitlab.Ptr(true),
+			ListOptions: gitlab.ListOptions{
+				PerPage: util.DefaultPerPage(100),
+			},
+		}
+		if args.ListOptions.Search != "" {
+			opt.Search = gitlab.Ptr(args.ListOptions.Search)
+		}
+		if args.ListOptions.IncludeAncestorGroups {
+			opt.IncludeAncestorGroups = gitlab.Ptr(true)
+		}
+
+		var err error
+		labels, _, err = util.GitlabClientFromContext(ctx).Labels.ListLabels(args.ProjectPath, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to list labels: %v", err)), nil
+		}
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Labels for %s:\n\n", labelScope(args)))
+
+	if len(labels) == 0 {
+		result.WriteString("No labels found.\n")
+		return mcp.NewToolResultText(result.String()), nil
+	}
+
+	for _, label := range labels {
+		result.WriteString(formatLabelInfo(label))
+		result.WriteString("\n")
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// Handle create label action
+func handleCreateLabel(ctx context.Context, args LabelManagementArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.CreateLabelOptions{
+		Name:  gitlab.Ptr(args.Name),
+		Color: gitlab.Ptr(args.LabelOptions.Color),
+	}
+	if args.LabelOptions.Description != "" {
+		opt.Description = gitlab.Ptr(args.LabelOptions.Description)
+	}
+
+	var label *gitlab.Label
+	if args.GroupID != "" {
+		groupLabel, _, err := util.GitlabClientFromContext(ctx).GroupLabels.CreateGroupLabel(args.GroupID, (*gitlab.CreateGroupLabelOptions)(opt), gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to create group label: %v", err)), nil
+		}
+		label = (*gitlab.Label)(groupLabel)
+	} else {
+		var err error
+		label, _, err = util.GitlabClientFromContext(ctx).Labels.CreateLabel(args.ProjectPath, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to create label: %v", err)), nil
+		}
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("✅ Label created in %s\n\n", labelScope(args)) + formatLabelInfo(label)), nil
+}
+
+// Handle update label action
+func handleUpdateLabel(ctx context.Context, args LabelManagementArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.UpdateLabelOptions{}
+	if args.LabelOptions.NewName != "" {
+		opt.NewName = gitlab.Ptr(args.LabelOptions.NewName)
+	}
+	if args.LabelOptions.Color != "" {
+		opt.Color = gitlab.Ptr(args.LabelOptions.Color)
+	}
+	if args.LabelOptions.Description != "" {
+		opt.Description = gitlab.Ptr(args.LabelOptions.Description)
+	}
+
+	var label *gitlab.Label
+	if args.GroupID != "" {
+		groupLabel, _, err := util.GitlabClientFromContext(ctx).GroupLabels.UpdateGroupLabel(args.GroupID, args.Name, (*gitlab.UpdateGroupLabelOptions)(opt), gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to update group label: %v", err)), nil
+		}
+		label = (*gitlab.Label)(groupLabel)
+	} else {
+		var err error
+		label, _, err = util.GitlabClientFromContext(ctx).Labels.UpdateLabel(args.ProjectPath, args.Name, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to update label: %v", err)), nil
+		}
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("✅ Label updated in %s\n\n", labelScope(args)) + formatLabelInfo(label)), nil
+}
+
+// Handle delete label action
+func handleDeleteLabel(ctx context.Context, args LabelManagementArgs) (*mcp.CallToolResult, error) {
+	var err error
+	if args.GroupID != "" {
+		_, err = util.GitlabClientFromContext(ctx).GroupLabels.DeleteGroupLabel(args.GroupID, args.Name, nil, gitlab.WithContext(ctx))
+	} else {
+		_, err = util.GitlabClientFromContext(ctx).Labels.DeleteLabel(args.ProjectPath, args.Name, nil, gitlab.WithContext(ctx))
+	}
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to delete label: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("✅ Label %s deleted from %s", args.Name, labelScope(args))), nil
+}
+
+// Helper function to format label information
+func formatLabelInfo(label *gitlab.Label) string {
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Label: %s (ID: %d)\n", label.Name, label.ID))
+	result.WriteString(fmt.Sprintf("Color: %s\n", label.Color))
+	result.WriteString(fmt.Sprintf("Text Color: %s\n", label.TextColor))
+	result.WriteString(fmt.Sprintf("Subscribed: %v\n", label.Subscribed))
+	if label.Description != "" {
+		result.WriteString(fmt.Sprintf("Description: %s\n", label.Description))
+	}
+	result.WriteString(fmt.Sprintf("Open Issues: %d\n", label.OpenIssuesCount))
+	result.WriteString(fmt.Sprintf("Open MRs: %d\n", label.OpenMergeRequestsCount))
+	return result.String()
+}
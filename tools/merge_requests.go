@@ -3,8 +3,11 @@ package tools
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -14,22 +17,35 @@ import (
 
 // Consolidated MR Management Args with action-based approach
 type MergeRequestManagementArgs struct {
-	Action      string `json:"action" validate:"required,oneof=list get create update accept rebase changes"`
+	Action      string `json:"action" validate:"required,oneof=list get create update accept rebase rebase_and_merge changes changed_files approvals approve unapprove add_to_merge_train remove_from_merge_train participants"`
 	ProjectPath string `json:"project_path" validate:"required,min=1"`
 	MrIID       string `json:"mr_iid,omitempty" validate:"omitempty,min=1"`
 	Confirmed   bool   `json:"confirmed,omitempty"`
+	Identity    string `json:"identity,omitempty" validate:"omitempty,min=1"`
 	
 	// List action specific
 	ListOptions struct {
-		State string `json:"state" validate:"omitempty,oneof=opened closed merged all"`
+		State         string `json:"state" validate:"omitempty,oneof=opened closed merged all"`
+		Page          int    `json:"page,omitempty" validate:"omitempty,min=1"`
+		PerPage       int    `json:"per_page,omitempty" validate:"omitempty,min=1,max=100"`
+		ApprovalState string `json:"approval_state,omitempty" validate:"omitempty,oneof=approved unapproved"`
+
+		AuthorUsername   string `json:"author_username,omitempty" validate:"omitempty,min=1"`
+		AssigneeUsername string `json:"assignee_username,omitempty" validate:"omitempty,min=1"`
+		Labels           string `json:"labels,omitempty"`
+		TargetBranch     string `json:"target_branch,omitempty" validate:"omitempty,min=1"`
+		OrderBy          string `json:"order_by,omitempty" validate:"omitempty,oneof=created_at updated_at title"`
+		Sort             string `json:"sort,omitempty" validate:"omitempty,oneof=asc desc"`
 	} `json:"list_options,omitempty"`
 	
 	// Create action specific
 	CreateOptions struct {
 		SourceBranch string `json:"source_branch" validate:"required_with=CreateOptions,min=1"`
 		TargetBranch string `json:"target_branch" validate:"required_with=CreateOptions,min=1"`
-		Title        string `json:"title" validate:"required_with=CreateOptions,min=1,max=255"`
-		Description  string `json:"description" validate:"max=1000000"`
+		Title           string `json:"title" validate:"required_with=CreateOptions,min=1,max=255"`
+		Description     string `json:"description" validate:"max=1000000"`
+		TargetProjectID int    `json:"target_project_id,omitempty" validate:"omitempty,min=1"`
+		Labels          string `json:"labels,omitempty"`
 	} `json:"create_options,omitempty"`
 	
 	// Update action specific
@@ -41,6 +57,8 @@ type MergeRequestManagementArgs struct {
 		AssigneeID           int    `json:"assignee_id,omitempty" validate:"omitempty,min=1"`
 		MilestoneID          int    `json:"milestone_id,omitempty" validate:"omitempty,min=1"`
 		Labels               string `json:"labels,omitempty"`
+		AddLabels            string `json:"add_labels,omitempty"`
+		RemoveLabels         string `json:"remove_labels,omitempty"`
 		RemoveSourceBranch   bool   `json:"remove_source_branch,omitempty"`
 		Squash               bool   `json:"squash,omitempty"`
 		DiscussionLocked     bool   `json:"discussion_locked,omitempty"`
@@ -50,9 +68,10 @@ type MergeRequestManagementArgs struct {
 	AcceptOptions struct {
 		MergeCommitMessage        string `json:"merge_commit_message,omitempty" validate:"max=1000"`
 		SquashCommitMessage       string `json:"squash_commit_message,omitempty" validate:"max=1000"`
-		Squash                    bool   `json:"squash,omitempty"`
+		Squash                    *bool  `json:"squash,omitempty"`
 		ShouldRemoveSourceBranch  bool   `json:"should_remove_source_branch,omitempty"`
 		MergeWhenPipelineSucceeds bool   `json:"merge_when_pipeline_succeeds,omitempty"`
+		RequireSigned             bool   `json:"require_signed,omitempty"`
 	} `json:"accept_options,omitempty"`
 	
 	// Rebase action specific
@@ -60,24 +79,55 @@ type MergeRequestManagementArgs struct {
 		SkipCI bool `json:"skip_ci,omitempty"`
 	} `json:"rebase_options,omitempty"`
 	
+	// Approve action specific
+	ApproveOptions struct {
+		SHA              string `json:"sha,omitempty" validate:"omitempty,min=7,max=40"`
+		ApprovalPassword string `json:"approval_password,omitempty"`
+	} `json:"approve_options,omitempty"`
+	
 	// Changes action specific
 	ChangesOptions struct {
 		AccessRawDiffs bool `json:"access_raw_diffs,omitempty"`
 		Unidiff        bool `json:"unidiff,omitempty"`
 	} `json:"changes_options,omitempty"`
+
+	// Get action specific
+	GetOptions struct {
+		SummaryOnly bool `json:"summary_only,omitempty"`
+	} `json:"get_options,omitempty"`
 }
 
 // Consolidated MR Comments Args with action-based approach
 type MergeRequestCommentsArgs struct {
-	Action      string `json:"action" validate:"required,oneof=list create"`
-	ProjectPath string `json:"project_path" validate:"required,min=1"`
-	MrIID       string `json:"mr_iid" validate:"required,min=1"`
-	Confirmed   bool   `json:"confirmed,omitempty"`
+	Action       string `json:"action" validate:"required,oneof=list create mention list_discussions create_discussion reply resolve create_positioned"`
+	ProjectPath  string `json:"project_path" validate:"required,min=1"`
+	MrIID        string `json:"mr_iid" validate:"required,min=1"`
+	Confirmed    bool   `json:"confirmed,omitempty"`
+	Identity     string `json:"identity,omitempty" validate:"omitempty,min=1"`
+	DiscussionID string `json:"discussion_id,omitempty" validate:"omitempty,min=1"`
+	Resolved     *bool  `json:"resolved,omitempty"`
 	
 	// Create comment specific
 	CommentOptions struct {
 		Comment string `json:"comment" validate:"required_with=CommentOptions,min=1,max=1000000"`
 	} `json:"comment_options,omitempty"`
+	
+	// Mention specific
+	MentionOptions struct {
+		Usernames []string `json:"usernames" validate:"required_with=MentionOptions,min=1,dive,min=1"`
+		Message   string   `json:"message,omitempty" validate:"max=1000000"`
+	} `json:"mention_options,omitempty"`
+	
+	// Positioned (diff line) comment specific
+	PositionOptions struct {
+		OldPath  string `json:"old_path,omitempty" validate:"omitempty,min=1"`
+		NewPath  string `json:"new_path,omitempty" validate:"omitempty,min=1"`
+		OldLine  int    `json:"old_line,omitempty" validate:"omitempty,min=1"`
+		NewLine  int    `json:"new_line,omitempty" validate:"omitempty,min=1"`
+		BaseSHA  string `json:"base_sha,omitempty" validate:"omitempty,min=7,max=40"`
+		StartSHA string `json:"start_sha,omitempty" validate:"omitempty,min=7,max=40"`
+		HeadSHA  string `json:"head_sha,omitempty" validate:"omitempty,min=7,max=40"`
+	} `json:"position_options,omitempty"`
 }
 
 // Consolidated MR Pipeline Args with action-based approach
@@ -90,19 +140,45 @@ type MergeRequestPipelineArgs struct {
 
 // Legacy individual args for backward compatibility
 type ListMergeRequestsArgs struct {
-	ProjectPath string `json:"project_path" validate:"required,min=1"`
-	State       string `json:"state" validate:"omitempty,oneof=opened closed merged all"`
+	ProjectPath   string `json:"project_path" validate:"required,min=1"`
+	State         string `json:"state" validate:"omitempty,oneof=opened closed merged all"`
+	Page          int    `json:"page,omitempty" validate:"omitempty,min=1"`
+	PerPage       int    `json:"per_page,omitempty" validate:"omitempty,min=1,max=100"`
+	ApprovalState string `json:"approval_state,omitempty" validate:"omitempty,oneof=approved unapproved"`
+
+	AuthorUsername   string `json:"author_username,omitempty" validate:"omitempty,min=1"`
+	AssigneeUsername string `json:"assignee_username,omitempty" validate:"omitempty,min=1"`
+	Labels           string `json:"labels,omitempty"`
+	TargetBranch     string `json:"target_branch,omitempty" validate:"omitempty,min=1"`
+	OrderBy          string `json:"order_by,omitempty" validate:"omitempty,oneof=created_at updated_at title"`
+	Sort             string `json:"sort,omitempty" validate:"omitempty,oneof=asc desc"`
+}
+
+// Upper bound on merge requests gathered across pages when no page is requested
+const maxListedMergeRequests = 500
+
+// Approval state needs one API call per MR, so filtering on it checks at most this many open MRs
+const maxApprovalChecks = 50
+
+type ListGroupMergeRequestsArgs struct {
+	GroupID          string `json:"group_id" validate:"required,min=1"`
+	State            string `json:"state,omitempty" validate:"omitempty,oneof=opened closed merged all"`
+	Labels           string `json:"labels,omitempty"`
+	AssigneeUsername string `json:"assignee_username,omitempty" validate:"omitempty,min=1"`
+	ApprovalState    string `json:"approval_state,omitempty" validate:"omitempty,oneof=approved unapproved"`
 }
 
 type GetMergeRequestArgs struct {
 	ProjectPath string `json:"project_path" validate:"required,min=1"`
 	MrIID       string `json:"mr_iid" validate:"required,min=1"`
+	SummaryOnly bool   `json:"summary_only,omitempty"`
 }
 
 type CreateMRNoteArgs struct {
 	ProjectPath string `json:"project_path" validate:"required,min=1"`
 	MrIID       string `json:"mr_iid" validate:"required,min=1"`
 	Comment     string `json:"comment" validate:"required,min=1,max=1000000"`
+	Identity    string `json:"identity,omitempty" validate:"omitempty,min=1"`
 }
 
 type ListMRCommentsArgs struct {
@@ -111,11 +187,13 @@ type ListMRCommentsArgs struct {
 }
 
 type CreateMergeRequestArgs struct {
-	ProjectPath  string `json:"project_path" validate:"required,min=1"`
-	SourceBranch string `json:"source_branch" validate:"required,min=1"`
-	TargetBranch string `json:"target_branch" validate:"required,min=1"`
-	Title        string `json:"title" validate:"required,min=1,max=255"`
-	Description  string `json:"description" validate:"max=1000000"`
+	ProjectPath     string `json:"project_path" validate:"required,min=1"`
+	SourceBranch    string `json:"source_branch" validate:"required,min=1"`
+	TargetBranch    string `json:"target_branch" validate:"required,min=1"`
+	Title           string `json:"title" validate:"required,min=1,max=255"`
+	Description     string `json:"description" validate:"max=1000000"`
+	TargetProjectID int    `json:"target_project_id,omitempty" validate:"omitempty,min=1"`
+	Labels          string `json:"labels,omitempty"`
 }
 
 type AcceptMergeRequestArgs struct {
@@ -123,9 +201,10 @@ type AcceptMergeRequestArgs struct {
 	MrIID                     string `json:"mr_iid" validate:"required,min=1"`
 	MergeCommitMessage        string `json:"merge_commit_message,omitempty" validate:"max=1000"`
 	SquashCommitMessage       string `json:"squash_commit_message,omitempty" validate:"max=1000"`
-	Squash                    bool   `json:"squash,omitempty"`
+	Squash                    *bool  `json:"squash,omitempty"`
 	ShouldRemoveSourceBranch  bool   `json:"should_remove_source_branch,omitempty"`
 	MergeWhenPipelineSucceeds bool   `json:"merge_when_pipeline_succeeds,omitempty"`
+	RequireSigned             bool   `json:"require_signed,omitempty"`
 }
 
 type UpdateMergeRequestArgs struct {
@@ -138,6 +217,8 @@ type UpdateMergeRequestArgs struct {
 	AssigneeID        int    `json:"assignee_id,omitempty" validate:"omitempty,min=1"`
 	MilestoneID       int    `json:"milestone_id,omitempty" validate:"omitempty,min=1"`
 	Labels            string `json:"labels,omitempty"`
+	AddLabels         string `json:"add_labels,omitempty"`
+	RemoveLabels      string `json:"remove_labels,omitempty"`
 	RemoveSourceBranch bool  `json:"remove_source_branch,omitempty"`
 	Squash            bool   `json:"squash,omitempty"`
 	DiscussionLocked  bool   `json:"discussion_locked,omitempty"`
@@ -148,6 +229,36 @@ type GetMRApprovalsArgs struct {
 	MrIID       string `json:"mr_iid" validate:"required,min=1"`
 }
 
+type GetMRByURLArgs struct {
+	URL string `json:"url" validate:"required,url"`
+}
+
+type MRApprovalRulesArgs struct {
+	Action            string   `json:"action" validate:"required,oneof=list create update"`
+	ProjectPath       string   `json:"project_path" validate:"required,min=1"`
+	MrIID             string   `json:"mr_iid" validate:"required,min=1"`
+	RuleID            int      `json:"rule_id,omitempty" validate:"omitempty,min=1"`
+	Name              string   `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	ApprovalsRequired *int     `json:"approvals_required,omitempty" validate:"omitempty,min=0"`
+	Usernames         []string `json:"usernames,omitempty" validate:"omitempty,dive,min=1"`
+	GroupIDs          []int    `json:"group_ids,omitempty" validate:"omitempty,dive,min=1"`
+	Confirmed         bool     `json:"confirmed,omitempty"`
+}
+
+type ApproveMRArgs struct {
+	ProjectPath      string `json:"project_path" validate:"required,min=1"`
+	MrIID            string `json:"mr_iid" validate:"required,min=1"`
+	Identity         string `json:"identity,omitempty" validate:"omitempty,min=1"`
+	SHA              string `json:"sha,omitempty" validate:"omitempty,min=7,max=40"`
+	ApprovalPassword string `json:"approval_password,omitempty"`
+}
+
+type CanMergeArgs struct {
+	ProjectPath string `json:"project_path" validate:"required,min=1"`
+	MrIID       string `json:"mr_iid" validate:"required,min=1"`
+	Identity    string `json:"identity,omitempty" validate:"omitempty,min=1"`
+}
+
 type GetMRParticipantsArgs struct {
 	ProjectPath string `json:"project_path" validate:"required,min=1"`
 	MrIID       string `json:"mr_iid" validate:"required,min=1"`
@@ -184,17 +295,19 @@ type GetMRChangesArgs struct {
 func RegisterMergeRequestTools(s *server.MCPServer) {
 	// Consolidated MR Management Tool
 	mrManagementTool := mcp.NewTool("manage_merge_request",
-		mcp.WithDescription("Comprehensive merge request management with multiple actions: list, get, create, update, accept, rebase, changes"),
+		mcp.WithDescription("Comprehensive merge request management with multiple actions: list, get, create, update, accept, rebase, rebase_and_merge, changes, changed_files, approvals, approve, unapprove, add_to_merge_train, remove_from_merge_train, participants"),
 		mcp.WithString("action", 
 			mcp.Required(), 
-			mcp.Description("Action to perform: list, get, create, update, accept, rebase, changes")),
+			mcp.Description("Action to perform: list, get, create, update, accept, rebase, rebase_and_merge, changes, changed_files, approvals, approve, unapprove, add_to_merge_train, remove_from_merge_train, participants")),
 		mcp.WithString("project_path", 
 			mcp.Required(), 
 			mcp.Description("Project/repo path")),
 		mcp.WithString("mr_iid", 
-			mcp.Description("Merge request IID (required for get, update, accept, rebase, changes actions)")),
+			mcp.Description("Merge request IID (required for every action except list and create)")),
 		mcp.WithBoolean("confirmed", 
-			mcp.Description("Confirmation required for destructive operations (create, update, accept, rebase)")),
+			mcp.Description("Confirmation required for destructive operations (create, update, accept, rebase, rebase_and_merge, approve, unapprove, add_to_merge_train, remove_from_merge_train)")),
+		mcp.WithString("identity", 
+			mcp.Description("Configured identity to approve as; its token is read from GITLAB_TOKEN_<IDENTITY> (approve and unapprove actions only, defaults to the token the call runs with)")),
 		
 		// List options
 		mcp.WithObject("list_options",
@@ -205,6 +318,48 @@ func RegisterMergeRequestTools(s *server.MCPServer) {
 					"description": "MR state (opened/closed/merged/all)",
 					"default":     "all",
 				},
+				"page": map[string]any{
+					"type":        "integer",
+					"description": "Return only this page of results. When omitted, pages are fetched until 500 merge requests are collected",
+					"minimum":     1,
+				},
+				"per_page": map[string]any{
+					"type":        "integer",
+					"description": "Results per page (1-100)",
+					"minimum":     1,
+					"maximum":     100,
+				},
+				"approval_state": map[string]any{
+					"type":        "string",
+					"description": "Only return open MRs whose approval requirements are met (approved) or still pending (unapproved); shows approval status for each MR. Needs one API call per MR, so only the first 50 open MRs are checked",
+					"enum":        []string{"approved", "unapproved"},
+				},
+				"author_username": map[string]any{
+					"type":        "string",
+					"description": "Only MRs created by this user",
+				},
+				"assignee_username": map[string]any{
+					"type":        "string",
+					"description": "Only MRs assigned to this user",
+				},
+				"labels": map[string]any{
+					"type":        "string",
+					"description": "Comma-separated list of labels the MRs must have",
+				},
+				"target_branch": map[string]any{
+					"type":        "string",
+					"description": "Only MRs targeting this branch",
+				},
+				"order_by": map[string]any{
+					"type":        "string",
+					"description": "Order by created_at, updated_at or title (default: created_at)",
+					"enum":        []string{"created_at", "updated_at", "title"},
+				},
+				"sort": map[string]any{
+					"type":        "string",
+					"description": "Sort direction (default: desc)",
+					"enum":        []string{"asc", "desc"},
+				},
 			}),
 		),
 		
@@ -228,6 +383,14 @@ func RegisterMergeRequestTools(s *server.MCPServer) {
 					"type":        "string",
 					"description": "Merge request description",
 				},
+				"target_project_id": map[string]any{
+					"type":        "integer",
+					"description": "ID of the upstream project to open the MR against when project_path is a fork (defaults to project_path)",
+				},
+				"labels": map[string]any{
+					"type":        "string",
+					"description": "Comma-separated list of labels",
+				},
 			}),
 		),
 		
@@ -261,7 +424,15 @@ func RegisterMergeRequestTools(s *server.MCPServer) {
 				},
 				"labels": map[string]any{
 					"type":        "string",
-					"description": "Comma-separated list of labels",
+					"description": "Comma-separated list of labels; replaces all existing labels",
+				},
+				"add_labels": map[string]any{
+					"type":        "string",
+					"description": "Comma-separated labels to add, keeping existing labels",
+				},
+				"remove_labels": map[string]any{
+					"type":        "string",
+					"description": "Comma-separated labels to remove, keeping the others",
 				},
 				"remove_source_branch": map[string]any{
 					"type":        "boolean",
@@ -280,7 +451,7 @@ func RegisterMergeRequestTools(s *server.MCPServer) {
 		
 		// Accept options
 		mcp.WithObject("accept_options",
-			mcp.Description("Options for accept action"),
+			mcp.Description("Options for accept and rebase_and_merge actions"),
 			mcp.Properties(map[string]any{
 				"merge_commit_message": map[string]any{
 					"type":        "string",
@@ -292,7 +463,7 @@ func RegisterMergeRequestTools(s *server.MCPServer) {
 				},
 				"squash": map[string]any{
 					"type":        "boolean",
-					"description": "Squash commits when merging",
+					"description": "Squash commits when merging (defaults to the project's squash option when omitted)",
 				},
 				"should_remove_source_branch": map[string]any{
 					"type":        "boolean",
@@ -302,12 +473,16 @@ func RegisterMergeRequestTools(s *server.MCPServer) {
 					"type":        "boolean",
 					"description": "Merge when pipeline succeeds",
 				},
+				"require_signed": map[string]any{
+					"type":        "boolean",
+					"description": "Refuse to merge unless every commit in the MR has a verified signature; the merge is pinned to the verified head. Not supported with rebase_and_merge, since the rebase drops signatures",
+				},
 			}),
 		),
 		
 		// Rebase options
 		mcp.WithObject("rebase_options",
-			mcp.Description("Options for rebase action"),
+			mcp.Description("Options for rebase and rebase_and_merge actions"),
 			mcp.Properties(map[string]any{
 				"skip_ci": map[string]any{
 					"type":        "boolean",
@@ -316,6 +491,21 @@ func RegisterMergeRequestTools(s *server.MCPServer) {
 			}),
 		),
 		
+		// Approve options
+		mcp.WithObject("approve_options",
+			mcp.Description("Options for approve action"),
+			mcp.Properties(map[string]any{
+				"sha": map[string]any{
+					"type":        "string",
+					"description": "Only approve if this is still the MR head SHA",
+				},
+				"approval_password": map[string]any{
+					"type":        "string",
+					"description": "Current user's password, for projects that require re-authentication to approve",
+				},
+			}),
+		),
+		
 		// Changes options
 		mcp.WithObject("changes_options",
 			mcp.Description("Options for changes action"),
@@ -330,14 +520,25 @@ func RegisterMergeRequestTools(s *server.MCPServer) {
 				},
 			}),
 		),
+
+		// Get options
+		mcp.WithObject("get_options",
+			mcp.Description("Options for get action"),
+			mcp.Properties(map[string]any{
+				"summary_only": map[string]any{
+					"type":        "boolean",
+					"description": "List changed files with +/- line counts instead of full diffs, for large MRs",
+				},
+			}),
+		),
 	)
 
 	// Consolidated MR Comments Tool
 	mrCommentsTool := mcp.NewTool("manage_merge_request_comments",
-		mcp.WithDescription("Manage merge request comments with actions: list, create"),
+		mcp.WithDescription("Manage merge request comments with actions: list, create, mention, create_positioned (comment on a diff line), and resolvable discussion threads: list_discussions, create_discussion, reply, resolve"),
 		mcp.WithString("action", 
 			mcp.Required(), 
-			mcp.Description("Action to perform: list, create")),
+			mcp.Description("Action to perform: list, create, mention, create_positioned, list_discussions, create_discussion, reply, resolve")),
 		mcp.WithString("project_path", 
 			mcp.Required(), 
 			mcp.Description("Project/repo path")),
@@ -345,11 +546,17 @@ func RegisterMergeRequestTools(s *server.MCPServer) {
 			mcp.Required(), 
 			mcp.Description("Merge request IID")),
 		mcp.WithBoolean("confirmed", 
-			mcp.Description("Confirmation required for create action")),
+			mcp.Description("Confirmation required for create and mention actions")),
+		mcp.WithString("identity", 
+			mcp.Description("Configured identity to post as; its token is read from GITLAB_TOKEN_<IDENTITY> (defaults to the token the call runs with)")),
+		mcp.WithString("discussion_id", 
+			mcp.Description("Discussion thread ID (required for reply and resolve actions)")),
+		mcp.WithBoolean("resolved", 
+			mcp.Description("Resolve (true) or unresolve (false) the thread (resolve action, default: true)")),
 		
 		// Comment options
 		mcp.WithObject("comment_options",
-			mcp.Description("Options for create action"),
+			mcp.Description("Options for create, create_positioned, create_discussion and reply actions"),
 			mcp.Properties(map[string]any{
 				"comment": map[string]any{
 					"type":        "string",
@@ -357,6 +564,59 @@ func RegisterMergeRequestTools(s *server.MCPServer) {
 				},
 			}),
 		),
+		
+		// Mention options
+		mcp.WithObject("mention_options",
+			mcp.Description("Options for mention action"),
+			mcp.Properties(map[string]any{
+				"usernames": map[string]any{
+					"type":        "array",
+					"description": "Usernames to mention (without the leading @)",
+					"items": map[string]any{
+						"type": "string",
+					},
+				},
+				"message": map[string]any{
+					"type":        "string",
+					"description": "Optional message to post after the mentions",
+				},
+			}),
+		),
+		
+		// Position options
+		mcp.WithObject("position_options",
+			mcp.Description("Diff position for create_positioned action. Use new_path/new_line for added or unchanged lines, old_path/old_line for removed lines. SHAs default to the MR's current diff refs"),
+			mcp.Properties(map[string]any{
+				"old_path": map[string]any{
+					"type":        "string",
+					"description": "File path before the change (defaults to new_path)",
+				},
+				"new_path": map[string]any{
+					"type":        "string",
+					"description": "File path after the change (defaults to old_path)",
+				},
+				"old_line": map[string]any{
+					"type":        "integer",
+					"description": "Line number in the old version of the file",
+				},
+				"new_line": map[string]any{
+					"type":        "integer",
+					"description": "Line number in the new version of the file",
+				},
+				"base_sha": map[string]any{
+					"type":        "string",
+					"description": "Base commit SHA of the diff",
+				},
+				"start_sha": map[string]any{
+					"type":        "string",
+					"description": "Start commit SHA of the diff",
+				},
+				"head_sha": map[string]any{
+					"type":        "string",
+					"description": "Head commit SHA of the diff",
+				},
+			}),
+		),
 	)
 
 	// Consolidated MR Pipeline Tool
@@ -382,24 +642,74 @@ func RegisterMergeRequestTools(s *server.MCPServer) {
 		mcp.WithString("mr_iid", mcp.Required(), mcp.Description("Merge request IID")),
 	)
 
+	// MR lookup by web URL
+	getMRByURLTool := mcp.NewTool("get_mr_by_url",
+		mcp.WithDescription("Get merge request details from its web URL (e.g. https://gitlab.com/group/project/-/merge_requests/42)"),
+		mcp.WithString("url", mcp.Required(), mcp.Description("Full merge request URL")),
+	)
+
+	// Merge permission pre-check
+	canMergeTool := mcp.NewTool("can_merge",
+		mcp.WithDescription("Check whether the current token could merge a merge request: project access level, target branch merge permissions, merge status and approvals"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
+		mcp.WithString("mr_iid", mcp.Required(), mcp.Description("Merge request IID")),
+		mcp.WithString("identity", mcp.Description("Check as this configured identity (token from GITLAB_TOKEN_<IDENTITY>) instead of the token the call runs with")),
+	)
+
+	// MR approval rules
+	mrApprovalRulesTool := mcp.NewTool("manage_mr_approval_rules",
+		mcp.WithDescription("Inspect and edit a merge request's approval rules (required approvals, eligible users and groups): list, create, update"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: list, create, update")),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
+		mcp.WithString("mr_iid", mcp.Required(), mcp.Description("Merge request IID")),
+		mcp.WithNumber("rule_id", mcp.Description("Approval rule ID, as shown by list (required for update)")),
+		mcp.WithString("name", mcp.Description("Rule name (required for create)")),
+		mcp.WithNumber("approvals_required", mcp.Description("Number of approvals the rule requires (required for create)")),
+		mcp.WithArray("usernames", mcp.Description("Usernames of the eligible approvers; on update, replaces the current users"), mcp.Items(map[string]any{"type": "string"})),
+		mcp.WithArray("group_ids", mcp.Description("IDs of groups whose members are eligible approvers; on update, replaces the current groups"), mcp.Items(map[string]any{"type": "integer"})),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for create and update actions")),
+	)
+
+	// Group-wide MR listing
+	listGroupMRsTool := mcp.NewTool("list_group_merge_requests",
+		mcp.WithDescription("List merge requests across all projects of a group, optionally filtered by state, labels and assignee"),
+		mcp.WithString("group_id", mcp.Required(), mcp.Description("Group ID or full path")),
+		mcp.WithString("state", mcp.Description("MR state (opened/closed/merged/all, default: opened)")),
+		mcp.WithString("labels", mcp.Description("Comma-separated list of labels the merge requests must have")),
+		mcp.WithString("assignee_username", mcp.Description("Only merge requests assigned to this user")),
+		mcp.WithString("approval_state", mcp.Description("Only open MRs whose approval requirements are met (approved) or still pending (unapproved); checks the first 50 open MRs, one API call each"), mcp.Enum("approved", "unapproved")),
+	)
+
 	// Register consolidated tools
 	s.AddTool(mrManagementTool, mcp.NewTypedToolHandler(mergeRequestManagementHandler))
 	s.AddTool(mrCommentsTool, mcp.NewTypedToolHandler(mergeRequestCommentsHandler))
 	s.AddTool(mrPipelineTool, mcp.NewTypedToolHandler(mergeRequestPipelineHandler))
 	s.AddTool(getMRCommitsTool, mcp.NewTypedToolHandler(getMRCommitsHandler))
+	s.AddTool(getMRByURLTool, mcp.NewTypedToolHandler(getMRByURLHandler))
+	s.AddTool(canMergeTool, mcp.NewTypedToolHandler(canMergeHandler))
+	s.AddTool(listGroupMRsTool, mcp.NewTypedToolHandler(listGroupMergeRequestsHandler))
+	s.AddTool(mrApprovalRulesTool, mcp.NewTypedToolHandler(mrApprovalRulesHandler))
 }
 
 // Consolidated MR Management Handler
 func mergeRequestManagementHandler(ctx context.Context, request mcp.CallToolRequest, args MergeRequestManagementArgs) (*mcp.CallToolResult, error) {
 	switch args.Action {
 	case "list":
-		state := "all"
-		if args.ListOptions.State != "" {
-			state = args.ListOptions.State
-		}
+		// An empty state is left for listMergeRequestsHandler to default, so
+		// approval_state can narrow it to opened instead of tripping over "all"
 		return listMergeRequestsHandler(ctx, request, ListMergeRequestsArgs{
 			ProjectPath: args.ProjectPath,
-			State:       state,
+			State:       args.ListOptions.State,
+			Page:          args.ListOptions.Page,
+			PerPage:       args.ListOptions.PerPage,
+			ApprovalState: args.ListOptions.ApprovalState,
+
+			AuthorUsername:   args.ListOptions.AuthorUsername,
+			AssigneeUsername: args.ListOptions.AssigneeUsername,
+			Labels:           args.ListOptions.Labels,
+			TargetBranch:     args.ListOptions.TargetBranch,
+			OrderBy:          args.ListOptions.OrderBy,
+			Sort:             args.ListOptions.Sort,
 		})
 	
 	case "get":
@@ -409,6 +719,7 @@ func mergeRequestManagementHandler(ctx context.Context, request mcp.CallToolRequ
 		return getMergeRequestHandler(ctx, request, GetMergeRequestArgs{
 			ProjectPath: args.ProjectPath,
 			MrIID:       args.MrIID,
+			SummaryOnly: args.GetOptions.SummaryOnly,
 		})
 	
 	case "create":
@@ -422,8 +733,10 @@ func mergeRequestManagementHandler(ctx context.Context, request mcp.CallToolRequ
 			ProjectPath:  args.ProjectPath,
 			SourceBranch: args.CreateOptions.SourceBranch,
 			TargetBranch: args.CreateOptions.TargetBranch,
-			Title:        args.CreateOptions.Title,
-			Description:  args.CreateOptions.Description,
+			Title:           args.CreateOptions.Title,
+			Description:     args.CreateOptions.Description,
+			TargetProjectID: args.CreateOptions.TargetProjectID,
+			Labels:          args.CreateOptions.Labels,
 		})
 	
 	case "update":
@@ -443,6 +756,8 @@ func mergeRequestManagementHandler(ctx context.Context, request mcp.CallToolRequ
 			AssigneeID:        args.UpdateOptions.AssigneeID,
 			MilestoneID:       args.UpdateOptions.MilestoneID,
 			Labels:            args.UpdateOptions.Labels,
+			AddLabels:         args.UpdateOptions.AddLabels,
+			RemoveLabels:      args.UpdateOptions.RemoveLabels,
 			RemoveSourceBranch: args.UpdateOptions.RemoveSourceBranch,
 			Squash:            args.UpdateOptions.Squash,
 			DiscussionLocked:  args.UpdateOptions.DiscussionLocked,
@@ -463,6 +778,7 @@ func mergeRequestManagementHandler(ctx context.Context, request mcp.CallToolRequ
 			Squash:                   args.AcceptOptions.Squash,
 			ShouldRemoveSourceBranch: args.AcceptOptions.ShouldRemoveSourceBranch,
 			MergeWhenPipelineSucceeds: args.AcceptOptions.MergeWhenPipelineSucceeds,
+			RequireSigned:             args.AcceptOptions.RequireSigned,
 		})
 	
 	case "rebase":
@@ -478,6 +794,24 @@ func mergeRequestManagementHandler(ctx context.Context, request mcp.CallToolRequ
 			SkipCI:      args.RebaseOptions.SkipCI,
 		})
 	
+	case "rebase_and_merge":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with rebasing and merging the merge request."), nil
+		}
+		if args.MrIID == "" {
+			return mcp.NewToolResultError("mr_iid is required for rebase_and_merge action"), nil
+		}
+		return rebaseAndMergeHandler(ctx, request, args.RebaseOptions.SkipCI, AcceptMergeRequestArgs{
+			ProjectPath:               args.ProjectPath,
+			MrIID:                    args.MrIID,
+			MergeCommitMessage:       args.AcceptOptions.MergeCommitMessage,
+			SquashCommitMessage:      args.AcceptOptions.SquashCommitMessage,
+			Squash:                   args.AcceptOptions.Squash,
+			ShouldRemoveSourceBranch: args.AcceptOptions.ShouldRemoveSourceBranch,
+			MergeWhenPipelineSucceeds: args.AcceptOptions.MergeWhenPipelineSucceeds,
+			RequireSigned:             args.AcceptOptions.RequireSigned,
+		})
+	
 	case "changes":
 		if args.MrIID == "" {
 			return mcp.NewToolResultError("mr_iid is required for changes action"), nil
@@ -489,8 +823,81 @@ func mergeRequestManagementHandler(ctx context.Context, request mcp.CallToolRequ
 			Unidiff:        args.ChangesOptions.Unidiff,
 		})
 	
+	case "changed_files":
+		if args.MrIID == "" {
+			return mcp.NewToolResultError("mr_iid is required for changed_files action"), nil
+		}
+		return getMRChangedFilesHandler(ctx, request, GetMergeRequestArgs{
+			ProjectPath: args.ProjectPath,
+			MrIID:       args.MrIID,
+		})
+	
+	case "approvals":
+		if args.MrIID == "" {
+			return mcp.NewToolResultError("mr_iid is required for approvals action"), nil
+		}
+		return getMRApprovalsHandler(ctx, request, GetMRApprovalsArgs{
+			ProjectPath: args.ProjectPath,
+			MrIID:       args.MrIID,
+		})
+	
+	case "approve":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with approving the merge request."), nil
+		}
+		if args.MrIID == "" {
+			return mcp.NewToolResultError("mr_iid is required for approve action"), nil
+		}
+		return approveMergeRequestHandler(ctx, request, ApproveMRArgs{
+			ProjectPath: args.ProjectPath,
+			MrIID:            args.MrIID,
+			Identity:         args.Identity,
+			SHA:              args.ApproveOptions.SHA,
+			ApprovalPassword: args.ApproveOptions.ApprovalPassword,
+		})
+	
+	case "unapprove":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with removing your approval from the merge request."), nil
+		}
+		if args.MrIID == "" {
+			return mcp.NewToolResultError("mr_iid is required for unapprove action"), nil
+		}
+		return unapproveMergeRequestHandler(ctx, request, ApproveMRArgs{
+			ProjectPath: args.ProjectPath,
+			MrIID:       args.MrIID,
+			Identity:    args.Identity,
+		})
+
+	case "add_to_merge_train":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with adding the merge request to the merge train."), nil
+		}
+		if args.MrIID == "" {
+			return mcp.NewToolResultError("mr_iid is required for add_to_merge_train action"), nil
+		}
+		return addToMergeTrain(ctx, args.ProjectPath, args.MrIID, args.AcceptOptions.Squash)
+
+	case "remove_from_merge_train":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with removing the merge request from the merge train."), nil
+		}
+		if args.MrIID == "" {
+			return mcp.NewToolResultError("mr_iid is required for remove_from_merge_train action"), nil
+		}
+		return removeFromMergeTrain(ctx, args.ProjectPath, args.MrIID)
+
+	case "participants":
+		if args.MrIID == "" {
+			return mcp.NewToolResultError("mr_iid is required for participants action"), nil
+		}
+		return getMRParticipantsHandler(ctx, request, GetMRParticipantsArgs{
+			ProjectPath: args.ProjectPath,
+			MrIID:       args.MrIID,
+		})
+
 	default:
-		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list, get, create, update, accept, rebase, changes", args.Action)), nil
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list, get, create, update, accept, rebase, rebase_and_merge, changes, changed_files, approvals, approve, unapprove, add_to_merge_train, remove_from_merge_train, participants", args.Action)), nil
 	}
 }
 
@@ -514,88 +921,383 @@ func mergeRequestCommentsHandler(ctx context.Context, request mcp.CallToolReques
 			ProjectPath: args.ProjectPath,
 			MrIID:       args.MrIID,
 			Comment:     args.CommentOptions.Comment,
+			Identity:    args.Identity,
 		})
 	
-	default:
-		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list, create", args.Action)), nil
-	}
-}
-
-// Consolidated MR Pipeline Handler
-func mergeRequestPipelineHandler(ctx context.Context, request mcp.CallToolRequest, args MergeRequestPipelineArgs) (*mcp.CallToolResult, error) {
-	switch args.Action {
-	case "list":
-		return getMRPipelinesHandler(ctx, request, GetMRPipelinesArgs{
-			ProjectPath: args.ProjectPath,
-			MrIID:       args.MrIID,
-		})
+	case "mention":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with mentioning users."), nil
+		}
+		if len(args.MentionOptions.Usernames) == 0 {
+			return mcp.NewToolResultError("usernames is required for mention action"), nil
+		}
+		return mentionOnMergeRequest(ctx, args.ProjectPath, args.MrIID, args.MentionOptions.Usernames, args.MentionOptions.Message, args.Identity)
 	
-	case "create":
+	case "create_positioned":
 		if !args.Confirmed {
-			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating a pipeline."), nil
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating a comment."), nil
 		}
-		return createMRPipelineHandler(ctx, request, CreateMRPipelineArgs{
-			ProjectPath: args.ProjectPath,
-			MrIID:       args.MrIID,
-		})
+		if args.CommentOptions.Comment == "" {
+			return mcp.NewToolResultError("comment is required for create_positioned action"), nil
+		}
+		if args.PositionOptions.NewPath == "" && args.PositionOptions.OldPath == "" {
+			return mcp.NewToolResultError("new_path or old_path is required for create_positioned action"), nil
+		}
+		if args.PositionOptions.NewLine == 0 && args.PositionOptions.OldLine == 0 {
+			return mcp.NewToolResultError("new_line or old_line is required for create_positioned action"), nil
+		}
+		return createPositionedMRComment(ctx, args)
+	
+	case "list_discussions":
+		return listMRDiscussions(ctx, args)
+	
+	case "create_discussion", "reply":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with posting to the discussion."), nil
+		}
+		if args.CommentOptions.Comment == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("comment is required for %s action", args.Action)), nil
+		}
+		if args.Action == "reply" && args.DiscussionID == "" {
+			return mcp.NewToolResultError("discussion_id is required for reply action"), nil
+		}
+		return postMRDiscussion(ctx, args)
+	
+	case "resolve":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with resolving the discussion."), nil
+		}
+		if args.DiscussionID == "" {
+			return mcp.NewToolResultError("discussion_id is required for resolve action"), nil
+		}
+		return resolveMRDiscussion(ctx, args)
 	
 	default:
-		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list, create", args.Action)), nil
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list, create, create_positioned, mention, list_discussions, create_discussion, reply, resolve", args.Action)), nil
 	}
 }
 
-// New handler for update MR
-func updateMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest, args UpdateMergeRequestArgs) (*mcp.CallToolResult, error) {
+func listMRDiscussions(ctx context.Context, args MergeRequestCommentsArgs) (*mcp.CallToolResult, error) {
 	mrIID, err := strconv.Atoi(args.MrIID)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
 	}
 
-	opt := &gitlab.UpdateMergeRequestOptions{}
-	
-	if args.Title != "" {
-		opt.Title = &args.Title
-	}
-	if args.Description != "" {
-		opt.Description = &args.Description
-	}
-	if args.TargetBranch != "" {
-		opt.TargetBranch = &args.TargetBranch
-	}
-	if args.StateEvent != "" {
-		opt.StateEvent = &args.StateEvent
+	// Plain notes take up page slots too, so read every page to find all threads
+	opt := &gitlab.ListOptions{PerPage: util.DefaultPerPage(100)}
+	discussions, err := util.AllPages(opt, func() ([]*gitlab.Discussion, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).Discussions.ListMergeRequestDiscussions(args.ProjectPath, mrIID, (*gitlab.ListMergeRequestDiscussionsOptions)(opt), gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list merge request discussions: %v", err)), nil
 	}
-	if args.AssigneeID != 0 {
-		opt.AssigneeID = &args.AssigneeID
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Discussions for Merge Request !%d:\n\n", mrIID))
+
+	threads := 0
+	for _, discussion := range discussions {
+		// Plain notes show up as single-note discussions; only list real threads
+		if discussion.IndividualNote || len(discussion.Notes) == 0 {
+			continue
+		}
+		threads++
+		result.WriteString(formatMRDiscussion(discussion))
+		result.WriteString("\n")
 	}
-	if args.MilestoneID != 0 {
-		opt.MilestoneID = &args.MilestoneID
+
+	if threads == 0 {
+		result.WriteString("No discussion threads found.\n")
 	}
-	// TODO: Fix Labels field assignment - requires proper LabelOptions type
-	// if args.Labels != "" {
-	//	opt.Labels = gitlab.Ptr(args.Labels)
-	// }
-	if args.RemoveSourceBranch {
-		opt.RemoveSourceBranch = &args.RemoveSourceBranch
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func postMRDiscussion(ctx context.Context, args MergeRequestCommentsArgs) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(args.MrIID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
 	}
-	if args.Squash {
-		opt.Squash = &args.Squash
+
+	client, err := util.GitlabClientFor(ctx, args.Identity)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
-	if args.DiscussionLocked {
-		opt.DiscussionLocked = &args.DiscussionLocked
+
+	if args.Action == "reply" {
+		note, _, err := client.Discussions.AddMergeRequestDiscussionNote(args.ProjectPath, mrIID, args.DiscussionID, &gitlab.AddMergeRequestDiscussionNoteOptions{
+			Body: gitlab.Ptr(args.CommentOptions.Comment),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to reply to discussion: %v", err)), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("Reply posted to discussion %s\nNote ID: %d\nAuthor: %s\nContent: %s",
+			args.DiscussionID, note.ID, note.Author.Username, note.Body)), nil
 	}
 
-	mr, _, err := util.GitlabClient().MergeRequests.UpdateMergeRequest(args.ProjectPath, mrIID, opt)
+	discussion, _, err := client.Discussions.CreateMergeRequestDiscussion(args.ProjectPath, mrIID, &gitlab.CreateMergeRequestDiscussionOptions{
+		Body: gitlab.Ptr(args.CommentOptions.Comment),
+	}, gitlab.WithContext(ctx))
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to update merge request: %v", err)), nil
+		return mcp.NewToolResultError(fmt.Sprintf("failed to create discussion: %v", err)), nil
 	}
 
-	result := strings.Builder{}
-	result.WriteString("Merge Request updated successfully!\n\n")
-	result.WriteString(fmt.Sprintf("MR #%d: %s\n", mr.IID, mr.Title))
+	return mcp.NewToolResultText("Discussion started successfully!\n" + formatMRDiscussion(discussion)), nil
+}
+
+func createPositionedMRComment(ctx context.Context, args MergeRequestCommentsArgs) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(args.MrIID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
+	}
+
+	client, err := util.GitlabClientFor(ctx, args.Identity)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	pos := args.PositionOptions
+	if pos.BaseSHA == "" || pos.StartSHA == "" || pos.HeadSHA == "" {
+		mr, _, err := client.MergeRequests.GetMergeRequest(args.ProjectPath, mrIID, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request diff refs: %v", err)), nil
+		}
+		if pos.BaseSHA == "" {
+			pos.BaseSHA = mr.DiffRefs.BaseSha
+		}
+		if pos.StartSHA == "" {
+			pos.StartSHA = mr.DiffRefs.StartSha
+		}
+		if pos.HeadSHA == "" {
+			pos.HeadSHA = mr.DiffRefs.HeadSha
+		}
+	}
+
+	// GitLab needs both paths even when the file wasn't renamed
+	if pos.OldPath == "" {
+		pos.OldPath = pos.NewPath
+	}
+	if pos.NewPath == "" {
+		pos.NewPath = pos.OldPath
+	}
+
+	position := &gitlab.PositionOptions{
+		BaseSHA:      gitlab.Ptr(pos.BaseSHA),
+		StartSHA:     gitlab.Ptr(pos.StartSHA),
+		HeadSHA:      gitlab.Ptr(pos.HeadSHA),
+		PositionType: gitlab.Ptr("text"),
+		OldPath:      gitlab.Ptr(pos.OldPath),
+		NewPath:      gitlab.Ptr(pos.NewPath),
+	}
+	if pos.OldLine != 0 {
+		position.OldLine = gitlab.Ptr(pos.OldLine)
+	}
+	if pos.NewLine != 0 {
+		position.NewLine = gitlab.Ptr(pos.NewLine)
+	}
+
+	discussion, _, err := client.Discussions.CreateMergeRequestDiscussion(args.ProjectPath, mrIID, &gitlab.CreateMergeRequestDiscussionOptions{
+		Body:     gitlab.Ptr(args.CommentOptions.Comment),
+		Position: position,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to create positioned comment: %v; check that the line is part of the diff", err)), nil
+	}
+
+	return mcp.NewToolResultText("Comment posted on the diff successfully!\n" + formatMRDiscussion(discussion)), nil
+}
+
+func resolveMRDiscussion(ctx context.Context, args MergeRequestCommentsArgs) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(args.MrIID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
+	}
+
+	client, err := util.GitlabClientFor(ctx, args.Identity)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	resolved := true
+	if args.Resolved != nil {
+		resolved = *args.Resolved
+	}
+
+	discussion, _, err := client.Discussions.ResolveMergeRequestDiscussion(args.ProjectPath, mrIID, args.DiscussionID, &gitlab.ResolveMergeRequestDiscussionOptions{
+		Resolved: gitlab.Ptr(resolved),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to update discussion: %v", err)), nil
+	}
+
+	status := "resolved"
+	if !resolved {
+		status = "unresolved"
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Discussion %s\n%s", status, formatMRDiscussion(discussion))), nil
+}
+
+// Helper function to format a discussion thread with its notes
+func formatMRDiscussion(discussion *gitlab.Discussion) string {
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Discussion ID: %s\n", discussion.ID))
+
+	if len(discussion.Notes) > 0 {
+		first := discussion.Notes[0]
+		if first.Resolvable {
+			if first.Resolved {
+				resolvedBy := ""
+				if first.ResolvedBy.Username != "" {
+					resolvedBy = " by " + first.ResolvedBy.Username
+				}
+				result.WriteString(fmt.Sprintf("Status: ✅ resolved%s\n", resolvedBy))
+			} else {
+				result.WriteString("Status: ⚠️ unresolved\n")
+			}
+		}
+		if first.Position != nil {
+			path := first.Position.NewPath
+			line := first.Position.NewLine
+			if path == "" {
+				path, line = first.Position.OldPath, first.Position.OldLine
+			}
+			result.WriteString(fmt.Sprintf("File: %s:%d\n", path, line))
+		}
+	}
+
+	for _, note := range discussion.Notes {
+		created := ""
+		if note.CreatedAt != nil {
+			created = note.CreatedAt.Format("2006-01-02 15:04:05")
+		}
+		result.WriteString(fmt.Sprintf("- [%d] %s (%s): %s\n", note.ID, note.Author.Username, created, note.Body))
+	}
+	return result.String()
+}
+
+// Helper function to post a note mentioning users after checking they exist
+func mentionOnMergeRequest(ctx context.Context, projectPath, mrIIDStr string, usernames []string, message, identity string) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(mrIIDStr)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
+	}
+
+	client, err := util.GitlabClientFor(ctx, identity)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	mentions := make([]string, 0, len(usernames))
+	for _, username := range usernames {
+		username = strings.TrimPrefix(strings.TrimSpace(username), "@")
+		if _, err := resolveUserID(ctx, username); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to resolve user %q: %v", username, err)), nil
+		}
+		mentions = append(mentions, "@"+username)
+	}
+
+	body := strings.Join(mentions, " ")
+	if message != "" {
+		body += " " + message
+	}
+
+	note, _, err := client.Notes.CreateMergeRequestNote(projectPath, mrIID, &gitlab.CreateMergeRequestNoteOptions{
+		Body: &body,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to create comment: %v", err)), nil
+	}
+
+	result := fmt.Sprintf("Mentioned %s on Merge Request !%d\nID: %d\nAuthor: %s\nCreated: %s\nContent: %s",
+		strings.Join(mentions, ", "), mrIID, note.ID, note.Author.Username, note.CreatedAt.Format("2006-01-02 15:04:05"), note.Body)
+
+	return mcp.NewToolResultText(result), nil
+}
+
+// Consolidated MR Pipeline Handler
+func mergeRequestPipelineHandler(ctx context.Context, request mcp.CallToolRequest, args MergeRequestPipelineArgs) (*mcp.CallToolResult, error) {
+	switch args.Action {
+	case "list":
+		return getMRPipelinesHandler(ctx, request, GetMRPipelinesArgs{
+			ProjectPath: args.ProjectPath,
+			MrIID:       args.MrIID,
+		})
+	
+	case "create":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating a pipeline."), nil
+		}
+		return createMRPipelineHandler(ctx, request, CreateMRPipelineArgs{
+			ProjectPath: args.ProjectPath,
+			MrIID:       args.MrIID,
+		})
+	
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list, create", args.Action)), nil
+	}
+}
+
+// New handler for update MR
+func updateMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest, args UpdateMergeRequestArgs) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(args.MrIID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
+	}
+
+	opt := &gitlab.UpdateMergeRequestOptions{}
+	
+	if args.Title != "" {
+		opt.Title = &args.Title
+	}
+	if args.Description != "" {
+		opt.Description = &args.Description
+	}
+	if args.TargetBranch != "" {
+		opt.TargetBranch = &args.TargetBranch
+	}
+	if args.StateEvent != "" {
+		opt.StateEvent = &args.StateEvent
+	}
+	if args.AssigneeID != 0 {
+		opt.AssigneeID = &args.AssigneeID
+	}
+	if args.MilestoneID != 0 {
+		opt.MilestoneID = &args.MilestoneID
+	}
+	if args.Labels != "" {
+		opt.Labels = parseLabels(args.Labels)
+	}
+	// add_labels/remove_labels adjust the current set instead of replacing it
+	if args.AddLabels != "" {
+		opt.AddLabels = parseLabels(args.AddLabels)
+	}
+	if args.RemoveLabels != "" {
+		opt.RemoveLabels = parseLabels(args.RemoveLabels)
+	}
+	if args.RemoveSourceBranch {
+		opt.RemoveSourceBranch = &args.RemoveSourceBranch
+	}
+	if args.Squash {
+		opt.Squash = &args.Squash
+	}
+	if args.DiscussionLocked {
+		opt.DiscussionLocked = &args.DiscussionLocked
+	}
+
+	mr, _, err := util.GitlabClientFromContext(ctx).MergeRequests.UpdateMergeRequest(args.ProjectPath, mrIID, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to update merge request: %v", err)), nil
+	}
+
+	result := strings.Builder{}
+	result.WriteString("Merge Request updated successfully!\n\n")
+	result.WriteString(fmt.Sprintf("MR #%d: %s\n", mr.IID, mr.Title))
 	result.WriteString(fmt.Sprintf("State: %s\n", mr.State))
 	result.WriteString(fmt.Sprintf("Source Branch: %s\n", mr.SourceBranch))
 	result.WriteString(fmt.Sprintf("Target Branch: %s\n", mr.TargetBranch))
+	if len(mr.Labels) > 0 {
+		result.WriteString(fmt.Sprintf("Labels: %s\n", strings.Join(mr.Labels, ", ")))
+	}
 	result.WriteString(fmt.Sprintf("Author: %s\n", mr.Author.Username))
 	result.WriteString(fmt.Sprintf("Updated: %s\n", mr.UpdatedAt.Format("2006-01-02 15:04:05")))
 	result.WriteString(fmt.Sprintf("URL: %s\n", mr.WebURL))
@@ -623,8 +1325,18 @@ func acceptMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest,
 	if args.SquashCommitMessage != "" {
 		opt.SquashCommitMessage = &args.SquashCommitMessage
 	}
-	if args.Squash {
-		opt.Squash = &args.Squash
+	if args.Squash != nil {
+		opt.Squash = args.Squash
+	} else {
+		// Follow the project's squash policy when the caller didn't choose
+		project, _, err := util.GitlabClientFromContext(ctx).Projects.GetProject(args.ProjectPath, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to get project: %v", err)), nil
+		}
+		switch project.SquashOption {
+		case gitlab.SquashOptionAlways, gitlab.SquashOptionDefaultOn:
+			opt.Squash = gitlab.Ptr(true)
+		}
 	}
 	if args.ShouldRemoveSourceBranch {
 		opt.ShouldRemoveSourceBranch = &args.ShouldRemoveSourceBranch
@@ -633,17 +1345,40 @@ func acceptMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest,
 		opt.MergeWhenPipelineSucceeds = &args.MergeWhenPipelineSucceeds
 	}
 
-	mr, _, err := util.GitlabClient().MergeRequests.AcceptMergeRequest(args.ProjectPath, mrIID, opt)
+	if args.RequireSigned {
+		headSHA, unsigned, err := unverifiedMergeRequestCommits(ctx, args.ProjectPath, mrIID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to check commit signatures: %v", err)), nil
+		}
+		if len(unsigned) > 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("refusing to merge: %d commit(s) are not signed with a verified signature:\n%s", len(unsigned), strings.Join(unsigned, "\n"))), nil
+		}
+		// Only merge the head that was verified; GitLab rejects the merge if commits were pushed since
+		if headSHA != "" {
+			opt.SHA = gitlab.Ptr(headSHA)
+		}
+	}
+
+	mr, _, err := util.GitlabClientFromContext(ctx).MergeRequests.AcceptMergeRequest(args.ProjectPath, mrIID, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to accept merge request: %v", err)), nil
 	}
 
 	result := strings.Builder{}
-	result.WriteString("Merge Request accepted successfully!\n\n")
+	// With merge_when_pipeline_succeeds GitLab only schedules the merge unless the pipeline already passed
+	if mr.State != "merged" && mr.MergeWhenPipelineSucceeds {
+		result.WriteString("⏳ Merge Request scheduled to merge when the pipeline succeeds\n\n")
+	} else {
+		result.WriteString("Merge Request accepted successfully!\n\n")
+	}
 	result.WriteString(fmt.Sprintf("MR #%d: %s\n", mr.IID, mr.Title))
 	result.WriteString(fmt.Sprintf("State: %s\n", mr.State))
 	result.WriteString(fmt.Sprintf("Source Branch: %s\n", mr.SourceBranch))
 	result.WriteString(fmt.Sprintf("Target Branch: %s\n", mr.TargetBranch))
+	result.WriteString(fmt.Sprintf("Squash: %v\n", opt.Squash != nil && *opt.Squash))
+	if mr.State != "merged" && mr.HeadPipeline != nil {
+		result.WriteString(fmt.Sprintf("Pipeline: #%d (%s) %s\n", mr.HeadPipeline.ID, mr.HeadPipeline.Status, mr.HeadPipeline.WebURL))
+	}
 	if mr.MergedAt != nil {
 		result.WriteString(fmt.Sprintf("Merged At: %s\n", mr.MergedAt.Format("2006-01-02 15:04:05")))
 	}
@@ -655,27 +1390,142 @@ func acceptMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest,
 	return mcp.NewToolResultText(result.String()), nil
 }
 
+// unverifiedMergeRequestCommits checks the signature of every commit in the MR.
+// It returns the head SHA that was checked, so the merge can be pinned to it,
+// and a line for every commit whose signature is missing or not verified.
+func unverifiedMergeRequestCommits(ctx context.Context, projectPath string, mrIID int) (string, []string, error) {
+	var headSHA string
+	var unverified []string
+	opt := &gitlab.GetMergeRequestCommitsOptions{PerPage: util.DefaultPerPage(100)}
+	for {
+		commits, resp, err := util.GitlabClientFromContext(ctx).MergeRequests.GetMergeRequestCommits(projectPath, mrIID, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", nil, err
+		}
+		// Commits are listed newest first
+		if headSHA == "" && len(commits) > 0 {
+			headSHA = commits[0].ID
+		}
+
+		for _, commit := range commits {
+			signature, sigResp, err := util.GitlabClientFromContext(ctx).Commits.GetGPGSignature(projectPath, commit.ID, gitlab.WithContext(ctx))
+			switch {
+			case sigResp != nil && sigResp.StatusCode == http.StatusNotFound:
+				unverified = append(unverified, fmt.Sprintf("- %s %s (unsigned)", commit.ShortID, commit.Title))
+			case err != nil:
+				return "", nil, err
+			case signature.VerificationStatus != "verified":
+				unverified = append(unverified, fmt.Sprintf("- %s %s (%s)", commit.ShortID, commit.Title, signature.VerificationStatus))
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+	return headSHA, unverified, nil
+}
+
 func listMergeRequestsHandler(ctx context.Context, request mcp.CallToolRequest, args ListMergeRequestsArgs) (*mcp.CallToolResult, error) {
 	state := args.State
 	if state == "" {
 		state = "all"
 	}
+	if args.ApprovalState != "" {
+		if args.State != "" && args.State != "opened" {
+			return mcp.NewToolResultError("approval_state only applies to opened merge requests"), nil
+		}
+		state = "opened"
+	}
+
+	perPage := args.PerPage
+	if perPage == 0 {
+		perPage = util.DefaultPerPage(100)
+	}
 
 	opt := &gitlab.ListProjectMergeRequestsOptions{
 		State: &state,
 		ListOptions: gitlab.ListOptions{
-			PerPage: 100,
+			PerPage: perPage,
+			Page:    args.Page,
 		},
 	}
+	if args.AuthorUsername != "" {
+		opt.AuthorUsername = gitlab.Ptr(args.AuthorUsername)
+	}
+	if args.AssigneeUsername != "" {
+		// The project MR list filters assignees by ID only
+		assigneeID, err := resolveUserID(ctx, args.AssigneeUsername)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		opt.AssigneeID = gitlab.AssigneeID(assigneeID)
+	}
+	if args.Labels != "" {
+		opt.Labels = parseLabels(args.Labels)
+	}
+	if args.TargetBranch != "" {
+		opt.TargetBranch = gitlab.Ptr(args.TargetBranch)
+	}
+	if args.OrderBy != "" {
+		opt.OrderBy = gitlab.Ptr(args.OrderBy)
+	}
+	if args.Sort != "" {
+		opt.Sort = gitlab.Ptr(args.Sort)
+	}
+
+	list := func() ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).MergeRequests.ListProjectMergeRequests(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	}
+
+	limit := maxListedMergeRequests
+	if args.ApprovalState != "" {
+		limit = maxApprovalChecks
+	}
 
-	mrs, _, err := util.GitlabClient().MergeRequests.ListProjectMergeRequests(args.ProjectPath, opt)
+	var mrs []*gitlab.BasicMergeRequest
+	var truncated bool
+	var err error
+	if args.Page != 0 {
+		// An explicit page returns just that page
+		mrs, _, err = list()
+		if args.ApprovalState != "" && len(mrs) > maxApprovalChecks {
+			mrs, truncated = mrs[:maxApprovalChecks], true
+		}
+	} else {
+		mrs, truncated, err = util.CollectPages(&opt.ListOptions, limit, list)
+	}
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to list merge requests: %v", err)), nil
 	}
+
+	var approvals map[int]*gitlab.MergeRequestApprovals
+	if args.ApprovalState != "" {
+		mrs, approvals, err = filterByApprovalState(ctx, mrs, args.ApprovalState)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
 	var result strings.Builder
+	if args.ApprovalState != "" && len(mrs) == 0 {
+		result.WriteString(fmt.Sprintf("No %s merge requests found.\n", args.ApprovalState))
+	}
 	for _, mr := range mrs {
 		result.WriteString(fmt.Sprintf("MR #%d: %s\nState: %s\nAuthor: %s\nURL: %s\nCreated: %s\n",
 			mr.IID, mr.Title, mr.State, mr.Author.Username, mr.WebURL, mr.CreatedAt.Format("2006-01-02 15:04:05")))
+		if args.OrderBy == "updated_at" && mr.UpdatedAt != nil {
+			result.WriteString(fmt.Sprintf("Updated: %s\n", mr.UpdatedAt.Format("2006-01-02 15:04:05")))
+		}
+
+		if approval, ok := approvals[mr.ID]; ok {
+			if approval.Approved {
+				result.WriteString(fmt.Sprintf("Approval: ✅ approved (%d approval(s))\n", len(approval.ApprovedBy)))
+			} else {
+				result.WriteString(fmt.Sprintf("Approval: ⏳ %d of %d approval(s) left\n", approval.ApprovalsLeft, approval.ApprovalsRequired))
+			}
+		}
 
 		if mr.SourceBranch != "" {
 			result.WriteString(fmt.Sprintf("Source Branch: %s\n", mr.SourceBranch))
@@ -696,9 +1546,95 @@ func listMergeRequestsHandler(ctx context.Context, request mcp.CallToolRequest,
 		result.WriteString("\n")
 	}
 
+	switch {
+	case truncated && args.ApprovalState != "":
+		result.WriteString(fmt.Sprintf("Checked approvals of the first %d open merge requests only. Narrow the filters to check the rest.\n", maxApprovalChecks))
+	case truncated:
+		result.WriteString(fmt.Sprintf("Showing the first %d merge requests. Use list_options.page to fetch specific pages.\n", maxListedMergeRequests))
+	}
+
 	return mcp.NewToolResultText(result.String()), nil
 }
 
+// filterByApprovalState keeps the MRs whose approval requirements are met
+// (approved) or still pending (unapproved). The list API has no approval
+// status, so it is looked up per MR; the lookups are returned keyed by MR ID.
+func filterByApprovalState(ctx context.Context, mrs []*gitlab.BasicMergeRequest, approvalState string) ([]*gitlab.BasicMergeRequest, map[int]*gitlab.MergeRequestApprovals, error) {
+	var filtered []*gitlab.BasicMergeRequest
+	approvals := make(map[int]*gitlab.MergeRequestApprovals)
+	for _, mr := range mrs {
+		approval, _, err := util.GitlabClientFromContext(ctx).MergeRequestApprovals.GetConfiguration(mr.ProjectID, mr.IID, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get approvals for MR !%d: %v", mr.IID, err)
+		}
+		if approval.Approved == (approvalState == "approved") {
+			filtered = append(filtered, mr)
+			approvals[mr.ID] = approval
+		}
+	}
+	return filtered, approvals, nil
+}
+
+func listGroupMergeRequestsHandler(ctx context.Context, request mcp.CallToolRequest, args ListGroupMergeRequestsArgs) (*mcp.CallToolResult, error) {
+	state := args.State
+	if state == "" {
+		state = "opened"
+	}
+	limit := maxListedMergeRequests
+	if args.ApprovalState != "" {
+		if state != "opened" {
+			return mcp.NewToolResultError("approval_state only applies to opened merge requests"), nil
+		}
+		limit = maxApprovalChecks
+	}
+
+	opt := &gitlab.ListGroupMergeRequestsOptions{
+		State: gitlab.Ptr(state),
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+	if args.Labels != "" {
+		opt.Labels = parseLabels(args.Labels)
+	}
+	if args.AssigneeUsername != "" {
+		assigneeID, err := resolveUserID(ctx, args.AssigneeUsername)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		opt.AssigneeID = gitlab.AssigneeID(assigneeID)
+	}
+
+	mrs, truncated, err := util.CollectPages(&opt.ListOptions, limit, func() ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).MergeRequests.ListGroupMergeRequests(args.GroupID, opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list group merge requests: %v", err)), nil
+	}
+
+	if args.ApprovalState != "" {
+		mrs, _, err = filterByApprovalState(ctx, mrs, args.ApprovalState)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		state = args.ApprovalState
+	}
+
+	if len(mrs) == 0 && !truncated {
+		return mcp.NewToolResultText(fmt.Sprintf("No %s merge requests found in group %s\n", state, args.GroupID)), nil
+	}
+
+	result := formatMergeRequestsResult(mrs)
+	switch {
+	case truncated && args.ApprovalState != "":
+		result += fmt.Sprintf("Checked approvals of the first %d open merge requests only. Narrow the filters to check the rest.\n", maxApprovalChecks)
+	case truncated:
+		result += fmt.Sprintf("Showing the first %d merge requests. Narrow the filters to see the rest.\n", maxListedMergeRequests)
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
+
 func getMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest, args GetMergeRequestArgs) (*mcp.CallToolResult, error) {
 	mrIID, err := strconv.Atoi(args.MrIID)
 	if err != nil {
@@ -706,13 +1642,20 @@ func getMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest, ar
 	}
 
 	// Get MR details
-	mr, _, err := util.GitlabClient().MergeRequests.GetMergeRequest(args.ProjectPath, mrIID, nil)
+	mr, resp, err := util.GitlabClientFromContext(ctx).MergeRequests.GetMergeRequest(args.ProjectPath, mrIID, nil, gitlab.WithContext(ctx))
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request: %v", err)), nil
+		return mcp.NewToolResultError(util.ResponseError("get merge request", fmt.Sprintf("merge request !%d in %s", mrIID, args.ProjectPath), resp, err)), nil
 	}
 
 	// Get detailed changes
-	changes, _, err := util.GitlabClient().MergeRequests.ListMergeRequestDiffs(args.ProjectPath, mrIID, nil)
+	opt := &gitlab.ListMergeRequestDiffsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+	changes, err := util.AllPages(&opt.ListOptions, func() ([]*gitlab.MergeRequestDiff, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).MergeRequests.ListMergeRequestDiffs(args.ProjectPath, mrIID, opt, gitlab.WithContext(ctx))
+	})
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request changes: %v", err)), nil
 	}
@@ -725,6 +1668,7 @@ func getMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest, ar
 	result.WriteString(fmt.Sprintf("Source Branch: %s\n", mr.SourceBranch))
 	result.WriteString(fmt.Sprintf("Target Branch: %s\n", mr.TargetBranch))
 	result.WriteString(fmt.Sprintf("State: %s\n", mr.State))
+	result.WriteString(fmt.Sprintf("Subscribed: %v\n", mr.Subscribed))
 	result.WriteString(fmt.Sprintf("Created: %s\n", mr.CreatedAt.Format("2006-01-02 15:04:05")))
 	// Add SHAs information
 	result.WriteString(fmt.Sprintf("Base SHA: %s\n", mr.DiffRefs.BaseSha))
@@ -741,19 +1685,23 @@ func getMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest, ar
 	result.WriteString(fmt.Sprintf("Changes Overview:\n"))
 	result.WriteString(fmt.Sprintf("Total files changed: %d\n\n", len(changes)))
 
+	if args.SummaryOnly {
+		totalAdded, totalDeleted := 0, 0
+		for _, change := range changes {
+			added, deleted := countDiffLines(change.Diff)
+			totalAdded += added
+			totalDeleted += deleted
+			result.WriteString(fmt.Sprintf("- %s: %s (+%d/-%d)\n", change.NewPath, mrDiffStatus(change), added, deleted))
+		}
+		result.WriteString(fmt.Sprintf("\nTotal: +%d/-%d\n", totalAdded, totalDeleted))
+		result.WriteString("Use the changes action or manage_repository_files to drill into specific files.\n")
+		return mcp.NewToolResultText(result.String()), nil
+	}
+
 	// Write detailed changes for each file
 	for _, change := range changes {
 		result.WriteString(fmt.Sprintf("File: %s\n", change.NewPath))
-		switch true {
-		case change.NewFile:
-			result.WriteString("Status: Added\n")
-		case change.DeletedFile:
-			result.WriteString("Status: Deleted\n")
-		case change.RenamedFile:
-			result.WriteString(fmt.Sprintf("Status: Renamed from %s\n", change.OldPath))
-		default:
-			result.WriteString("Status: Modified\n")
-		}
+		result.WriteString(fmt.Sprintf("Status: %s\n", mrDiffStatus(change)))
 
 		if change.Diff != "" {
 			result.WriteString("Diff:\n")
@@ -768,17 +1716,36 @@ func getMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest, ar
 	return mcp.NewToolResultText(result.String()), nil
 }
 
+// Helper function to describe how a merge request changed a file
+func mrDiffStatus(change *gitlab.MergeRequestDiff) string {
+	switch {
+	case change.NewFile:
+		return "Added"
+	case change.DeletedFile:
+		return "Deleted"
+	case change.RenamedFile:
+		return fmt.Sprintf("Renamed from %s", change.OldPath)
+	default:
+		return "Modified"
+	}
+}
+
 func commentOnMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest, args CreateMRNoteArgs) (*mcp.CallToolResult, error) {
 	mrIID, err := strconv.Atoi(args.MrIID)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
 	}
 
+	client, err := util.GitlabClientFor(ctx, args.Identity)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	opt := &gitlab.CreateMergeRequestNoteOptions{
 		Body: &args.Comment,
 	}
 
-	note, _, err := util.GitlabClient().Notes.CreateMergeRequestNote(args.ProjectPath, mrIID, opt)
+	note, _, err := client.Notes.CreateMergeRequestNote(args.ProjectPath, mrIID, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to create comment: %v", err)), nil
 	}
@@ -797,13 +1764,13 @@ func listMRCommentsHandler(ctx context.Context, request mcp.CallToolRequest, arg
 
 	opt := &gitlab.ListMergeRequestNotesOptions{
 		ListOptions: gitlab.ListOptions{
-			PerPage: 100,
+			PerPage: util.DefaultPerPage(100),
 		},
 		OrderBy: gitlab.Ptr("created_at"),
 		Sort:    gitlab.Ptr("desc"),
 	}
 
-	notes, _, err := util.GitlabClient().Notes.ListMergeRequestNotes(args.ProjectPath, mrIID, opt)
+	notes, _, err := util.GitlabClientFromContext(ctx).Notes.ListMergeRequestNotes(args.ProjectPath, mrIID, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to list merge request comments: %v", err)), nil
 	}
@@ -892,7 +1859,16 @@ func createMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest,
 		opt.Description = &args.Description
 	}
 
-	mr, _, err := util.GitlabClient().MergeRequests.CreateMergeRequest(args.ProjectPath, opt)
+	// Open the MR against another project, e.g. from a fork into upstream
+	if args.TargetProjectID != 0 {
+		opt.TargetProjectID = &args.TargetProjectID
+	}
+
+	if args.Labels != "" {
+		opt.Labels = parseLabels(args.Labels)
+	}
+
+	mr, _, err := util.GitlabClientFromContext(ctx).MergeRequests.CreateMergeRequest(args.ProjectPath, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to create merge request: %v", err)), nil
 	}
@@ -903,6 +1879,13 @@ func createMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest,
 	result.WriteString(fmt.Sprintf("State: %s\n", mr.State))
 	result.WriteString(fmt.Sprintf("Source Branch: %s\n", mr.SourceBranch))
 	result.WriteString(fmt.Sprintf("Target Branch: %s\n", mr.TargetBranch))
+	if mr.SourceProjectID != mr.TargetProjectID {
+		result.WriteString(fmt.Sprintf("Source Project ID: %d\n", mr.SourceProjectID))
+		result.WriteString(fmt.Sprintf("Target Project ID: %d\n", mr.TargetProjectID))
+	}
+	if len(mr.Labels) > 0 {
+		result.WriteString(fmt.Sprintf("Labels: %s\n", strings.Join(mr.Labels, ", ")))
+	}
 	result.WriteString(fmt.Sprintf("Author: %s\n", mr.Author.Username))
 	result.WriteString(fmt.Sprintf("Created: %s\n", mr.CreatedAt.Format("2006-01-02 15:04:05")))
 	result.WriteString(fmt.Sprintf("URL: %s\n", mr.WebURL))
@@ -921,7 +1904,7 @@ func getMRPipelinesHandler(ctx context.Context, request mcp.CallToolRequest, arg
 		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
 	}
 
-	pipelines, _, err := util.GitlabClient().MergeRequests.ListMergeRequestPipelines(args.ProjectPath, mrIID)
+	pipelines, _, err := util.GitlabClientFromContext(ctx).MergeRequests.ListMergeRequestPipelines(args.ProjectPath, mrIID, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request pipelines: %v", err)), nil
 	}
@@ -953,15 +1936,35 @@ func getMRPipelinesHandler(ctx context.Context, request mcp.CallToolRequest, arg
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func getMRCommitsHandler(ctx context.Context, request mcp.CallToolRequest, args GetMRCommitsArgs) (*mcp.CallToolResult, error) {
+func getMRParticipantsHandler(ctx context.Context, request mcp.CallToolRequest, args GetMRParticipantsArgs) (*mcp.CallToolResult, error) {
 	mrIID, err := strconv.Atoi(args.MrIID)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
 	}
 
-	commits, _, err := util.GitlabClient().MergeRequests.GetMergeRequestCommits(args.ProjectPath, mrIID, nil)
+	participants, _, err := util.GitlabClientFromContext(ctx).MergeRequests.GetMergeRequestParticipants(args.ProjectPath, mrIID, gitlab.WithContext(ctx))
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request commits: %v", err)), nil
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request participants: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Participants of Merge Request !%d (%d):\n\n", mrIID, len(participants)))
+	for _, user := range participants {
+		result.WriteString(fmt.Sprintf("- %s (%s)\n", user.Username, user.Name))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func getMRCommitsHandler(ctx context.Context, request mcp.CallToolRequest, args GetMRCommitsArgs) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(args.MrIID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
+	}
+
+	commits, _, err := util.GitlabClientFromContext(ctx).MergeRequests.GetMergeRequestCommits(args.ProjectPath, mrIID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request commits: %v", err)), nil
 	}
 
 	var result strings.Builder
@@ -1008,7 +2011,7 @@ func createMRPipelineHandler(ctx context.Context, request mcp.CallToolRequest, a
 		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
 	}
 
-	pipeline, _, err := util.GitlabClient().MergeRequests.CreateMergeRequestPipeline(args.ProjectPath, mrIID)
+	pipeline, _, err := util.GitlabClientFromContext(ctx).MergeRequests.CreateMergeRequestPipeline(args.ProjectPath, mrIID, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to create merge request pipeline: %v", err)), nil
 	}
@@ -1039,7 +2042,7 @@ func rebaseMRHandler(ctx context.Context, request mcp.CallToolRequest, args Reba
 		SkipCI: &args.SkipCI,
 	}
 
-	_, err = util.GitlabClient().MergeRequests.RebaseMergeRequest(args.ProjectPath, mrIID, opt)
+	_, err = util.GitlabClientFromContext(ctx).MergeRequests.RebaseMergeRequest(args.ProjectPath, mrIID, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to rebase merge request: %v", err)), nil
 	}
@@ -1063,7 +2066,7 @@ func getMRChangesHandler(ctx context.Context, request mcp.CallToolRequest, args
 		Unidiff:        &args.Unidiff,
 	}
 
-	mr, _, err := util.GitlabClient().MergeRequests.GetMergeRequestChanges(args.ProjectPath, mrIID, opt)
+	mr, _, err := util.GitlabClientFromContext(ctx).MergeRequests.GetMergeRequestChanges(args.ProjectPath, mrIID, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request changes: %v", err)), nil
 	}
@@ -1088,4 +2091,731 @@ func getMRChangesHandler(ctx context.Context, request mcp.CallToolRequest, args
 	result.WriteString("Note: This endpoint is deprecated. Consider using 'get_mr_details' instead for detailed changes information.\n")
 
 	return mcp.NewToolResultText(result.String()), nil
-} 
\ No newline at end of file
+} 
+
+func getMRApprovalsHandler(ctx context.Context, request mcp.CallToolRequest, args GetMRApprovalsArgs) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(args.MrIID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
+	}
+
+	mr, _, err := util.GitlabClientFromContext(ctx).MergeRequests.GetMergeRequest(args.ProjectPath, mrIID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request: %v", err)), nil
+	}
+
+	approvals, _, err := util.GitlabClientFromContext(ctx).MergeRequestApprovals.GetConfiguration(args.ProjectPath, mrIID, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request approvals: %v", err)), nil
+	}
+
+	projectApprovals, _, err := util.GitlabClientFromContext(ctx).Projects.GetApprovalConfiguration(args.ProjectPath, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get project approval configuration: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Approvals for Merge Request !%d: %s\n", mr.IID, mr.Title))
+	result.WriteString(fmt.Sprintf("Head SHA: %s\n", mr.SHA))
+	result.WriteString(fmt.Sprintf("Approved: %v\n", approvals.Approved))
+	result.WriteString(fmt.Sprintf("Approvals Required: %d\n", approvals.ApprovalsRequired))
+	result.WriteString(fmt.Sprintf("Approvals Left: %d\n", approvals.ApprovalsLeft))
+	result.WriteString(fmt.Sprintf("Reset Approvals On Push: %v\n", projectApprovals.ResetApprovalsOnPush))
+
+	state, _, err := util.GitlabClientFromContext(ctx).MergeRequestApprovals.GetApprovalState(args.ProjectPath, mrIID, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request approval state: %v", err)), nil
+	}
+	if len(state.Rules) > 0 {
+		result.WriteString("\nApproval Rules:\n")
+		result.WriteString(formatApprovalRules(state.Rules))
+	}
+
+	if len(approvals.ApprovedBy) == 0 {
+		result.WriteString("\nNo approvals yet.\n")
+		return mcp.NewToolResultText(result.String()), nil
+	}
+
+	// Approvals are dropped on every push when reset is enabled, so any that remain were given on the head SHA
+	if projectApprovals.ResetApprovalsOnPush {
+		result.WriteString("\nApproved By:\n")
+		for _, approver := range approvals.ApprovedBy {
+			result.WriteString(fmt.Sprintf("- %s\n", approver.User.Username))
+		}
+		result.WriteString(fmt.Sprintf("\nApproval Validity: current approvals are valid for head SHA %s (approvals reset on each push)\n", mr.SHA))
+		return mcp.NewToolResultText(result.String()), nil
+	}
+
+	// Otherwise compare each approval against the time the head SHA was pushed
+	versions, _, err := util.GitlabClientFromContext(ctx).MergeRequests.GetMergeRequestDiffVersions(args.ProjectPath, mrIID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request versions: %v", err)), nil
+	}
+
+	// Latest approval system note per user, paging back until every approver has one
+	approvedAt := make(map[string]*gitlab.Note)
+	notesOpt := &gitlab.ListMergeRequestNotesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+		OrderBy: gitlab.Ptr("created_at"),
+		Sort:    gitlab.Ptr("desc"),
+	}
+	for {
+		notes, resp, err := util.GitlabClientFromContext(ctx).Notes.ListMergeRequestNotes(args.ProjectPath, mrIID, notesOpt, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to list merge request notes: %v", err)), nil
+		}
+		for _, note := range notes {
+			if !note.System || !strings.HasPrefix(note.Body, "approved this merge request") {
+				continue
+			}
+			if _, ok := approvedAt[note.Author.Username]; !ok {
+				approvedAt[note.Author.Username] = note
+			}
+		}
+
+		if resp.NextPage == 0 || allApproversFound(approvals.ApprovedBy, approvedAt) {
+			break
+		}
+		notesOpt.Page = resp.NextPage
+	}
+
+	var headPushedAt *time.Time
+	for _, version := range versions {
+		if version.HeadCommitSHA == mr.SHA {
+			headPushedAt = version.CreatedAt
+			break
+		}
+	}
+
+	staleCount := 0
+	result.WriteString("\nApproved By:\n")
+	for _, approver := range approvals.ApprovedBy {
+		note, ok := approvedAt[approver.User.Username]
+		switch {
+		case !ok || note.CreatedAt == nil || headPushedAt == nil:
+			result.WriteString(fmt.Sprintf("- %s (approval time unknown)\n", approver.User.Username))
+		case note.CreatedAt.Before(*headPushedAt):
+			staleCount++
+			result.WriteString(fmt.Sprintf("- %s (approved %s, before head SHA was pushed)\n", approver.User.Username, note.CreatedAt.Format("2006-01-02 15:04:05")))
+		default:
+			result.WriteString(fmt.Sprintf("- %s (approved %s, on head SHA)\n", approver.User.Username, note.CreatedAt.Format("2006-01-02 15:04:05")))
+		}
+	}
+
+	if staleCount > 0 {
+		result.WriteString(fmt.Sprintf("\nApproval Validity: %d approval(s) were given before head SHA %s was pushed and still count because approvals are not reset on push\n", staleCount, mr.SHA))
+	} else {
+		result.WriteString(fmt.Sprintf("\nApproval Validity: no approvals are known to predate head SHA %s\n", mr.SHA))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// allApproversFound reports whether every approver has an approval note in approvedAt
+func allApproversFound(approvers []*gitlab.MergeRequestApproverUser, approvedAt map[string]*gitlab.Note) bool {
+	for _, approver := range approvers {
+		if _, ok := approvedAt[approver.User.Username]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func approveMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest, args ApproveMRArgs) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(args.MrIID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
+	}
+
+	client, err := util.GitlabClientFor(ctx, args.Identity)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	user, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get current user: %v", err)), nil
+	}
+
+	// Work out which pending rules this approval counts toward before approving
+	state, _, err := client.MergeRequestApprovals.GetApprovalState(args.ProjectPath, mrIID, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request approval state: %v", err)), nil
+	}
+
+	var satisfiable []*gitlab.MergeRequestApprovalRule
+	for _, rule := range state.Rules {
+		if rule.Approved {
+			continue
+		}
+		for _, approver := range rule.EligibleApprovers {
+			if approver.ID == user.ID {
+				satisfiable = append(satisfiable, rule)
+				break
+			}
+		}
+	}
+
+	opt := &approveOptions{}
+	if args.SHA != "" {
+		opt.SHA = gitlab.Ptr(args.SHA)
+	}
+	if args.ApprovalPassword != "" {
+		opt.ApprovalPassword = gitlab.Ptr(args.ApprovalPassword)
+	}
+
+	approvals, resp, err := approveMergeRequest(ctx, client, args.ProjectPath, mrIID, opt)
+	if err != nil {
+		if args.ApprovalPassword == "" && resp != nil && resp.StatusCode == http.StatusUnauthorized {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to approve merge request: %v (this project may require approval_password in approve_options)", err)), nil
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("failed to approve merge request: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Merge Request !%d approved by %s\n\n", mrIID, user.Username))
+	result.WriteString(fmt.Sprintf("Approved: %v\n", approvals.Approved))
+	result.WriteString(fmt.Sprintf("Approvals Left: %d\n", approvals.ApprovalsLeft))
+
+	if len(satisfiable) == 0 {
+		result.WriteString("\nThis approval does not count toward any pending approval rule.\n")
+	} else {
+		result.WriteString("\nThis approval counts toward:\n")
+		for _, rule := range satisfiable {
+			result.WriteString(fmt.Sprintf("- %s (%d/%d approvals before this one)\n", rule.Name, len(rule.ApprovedBy), rule.ApprovalsRequired))
+		}
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// approveOptions mirrors gitlab.ApproveMergeRequestOptions plus approval_password,
+// which projects that require password confirmation for approvals expect
+type approveOptions struct {
+	SHA              *string `url:"sha,omitempty" json:"sha,omitempty"`
+	ApprovalPassword *string `url:"approval_password,omitempty" json:"approval_password,omitempty"`
+}
+
+// approveMergeRequest posts to the approve endpoint directly because the client
+// library has no way to send approval_password
+func approveMergeRequest(ctx context.Context, client *gitlab.Client, projectPath string, mrIID int, opt *approveOptions) (*gitlab.MergeRequestApprovals, *gitlab.Response, error) {
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/approve", gitlab.PathEscape(projectPath), mrIID)
+	req, err := client.NewRequest(http.MethodPost, u, opt, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	approvals := new(gitlab.MergeRequestApprovals)
+	resp, err := client.Do(req, approvals)
+	if err != nil {
+		return nil, resp, err
+	}
+	return approvals, resp, nil
+}
+
+func unapproveMergeRequestHandler(ctx context.Context, request mcp.CallToolRequest, args ApproveMRArgs) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(args.MrIID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
+	}
+
+	client, err := util.GitlabClientFor(ctx, args.Identity)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	_, err = client.MergeRequestApprovals.UnapproveMergeRequest(args.ProjectPath, mrIID, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to unapprove merge request: %v", err)), nil
+	}
+
+	approvals, _, err := client.MergeRequestApprovals.GetConfiguration(args.ProjectPath, mrIID, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request approvals: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Approval removed from Merge Request !%d\n", mrIID))
+	result.WriteString(fmt.Sprintf("Approved: %v\n", approvals.Approved))
+	result.WriteString(fmt.Sprintf("Approvals Left: %d of %d required\n", approvals.ApprovalsLeft, approvals.ApprovalsRequired))
+	if len(approvals.ApprovedBy) > 0 {
+		approvedBy := make([]string, 0, len(approvals.ApprovedBy))
+		for _, approver := range approvals.ApprovedBy {
+			approvedBy = append(approvedBy, approver.User.Username)
+		}
+		result.WriteString(fmt.Sprintf("Approved By: %s\n", strings.Join(approvedBy, ", ")))
+	} else {
+		result.WriteString("Approved By: nobody\n")
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// Helper function to format per-rule approval satisfaction
+func formatApprovalRules(rules []*gitlab.MergeRequestApprovalRule) string {
+	var result strings.Builder
+	for _, rule := range rules {
+		status := "pending"
+		if rule.Approved {
+			status = "satisfied"
+		}
+		result.WriteString(fmt.Sprintf("- %s [%s]: %d/%d approvals (%s)\n", rule.Name, rule.RuleType, len(rule.ApprovedBy), rule.ApprovalsRequired, status))
+
+		if len(rule.ApprovedBy) > 0 {
+			approvedBy := make([]string, 0, len(rule.ApprovedBy))
+			for _, user := range rule.ApprovedBy {
+				approvedBy = append(approvedBy, user.Username)
+			}
+			result.WriteString(fmt.Sprintf("  Approved By: %s\n", strings.Join(approvedBy, ", ")))
+		}
+		if !rule.Approved && len(rule.EligibleApprovers) > 0 {
+			eligible := make([]string, 0, len(rule.EligibleApprovers))
+			for _, user := range rule.EligibleApprovers {
+				eligible = append(eligible, user.Username)
+			}
+			result.WriteString(fmt.Sprintf("  Eligible Approvers: %s\n", strings.Join(eligible, ", ")))
+		}
+	}
+	return result.String()
+}
+
+func mrApprovalRulesHandler(ctx context.Context, request mcp.CallToolRequest, args MRApprovalRulesArgs) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(args.MrIID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
+	}
+
+	switch args.Action {
+	case "list":
+		rules, _, err := util.GitlabClientFromContext(ctx).MergeRequestApprovals.GetApprovalRules(args.ProjectPath, mrIID, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to get approval rules: %v", err)), nil
+		}
+		if len(rules) == 0 {
+			return mcp.NewToolResultText(fmt.Sprintf("Merge request !%d has no approval rules\n", mrIID)), nil
+		}
+
+		var result strings.Builder
+		result.WriteString(fmt.Sprintf("Approval rules for merge request !%d:\n\n", mrIID))
+		for _, rule := range rules {
+			result.WriteString(formatApprovalRuleConfig(rule))
+		}
+		return mcp.NewToolResultText(result.String()), nil
+	case "create", "update":
+		if !args.Confirmed {
+			return mcp.NewToolResultError(fmt.Sprintf("This operation requires confirmation. Please set 'confirmed: true' to proceed with the approval rule %s.", args.Action)), nil
+		}
+		return saveMRApprovalRule(ctx, args, mrIID)
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("invalid action: %s. Valid actions are: list, create, update", args.Action)), nil
+	}
+}
+
+func saveMRApprovalRule(ctx context.Context, args MRApprovalRulesArgs, mrIID int) (*mcp.CallToolResult, error) {
+	// The API takes user IDs, usernames are easier to pass around
+	var userIDs *[]int
+	if args.Usernames != nil {
+		ids := make([]int, 0, len(args.Usernames))
+		for _, username := range args.Usernames {
+			id, err := resolveUserID(ctx, username)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ids = append(ids, id)
+		}
+		userIDs = &ids
+	}
+	var groupIDs *[]int
+	if args.GroupIDs != nil {
+		groupIDs = gitlab.Ptr(args.GroupIDs)
+	}
+
+	var rule *gitlab.MergeRequestApprovalRule
+	var err error
+	if args.Action == "create" {
+		if args.Name == "" || args.ApprovalsRequired == nil {
+			return mcp.NewToolResultError("name and approvals_required are required for create action"), nil
+		}
+		rule, _, err = util.GitlabClientFromContext(ctx).MergeRequestApprovals.CreateApprovalRule(args.ProjectPath, mrIID, &gitlab.CreateMergeRequestApprovalRuleOptions{
+			Name:              gitlab.Ptr(args.Name),
+			ApprovalsRequired: args.ApprovalsRequired,
+			UserIDs:           userIDs,
+			GroupIDs:          groupIDs,
+		}, gitlab.WithContext(ctx))
+	} else {
+		if args.RuleID == 0 {
+			return mcp.NewToolResultError("rule_id is required for update action"), nil
+		}
+		opt := &gitlab.UpdateMergeRequestApprovalRuleOptions{
+			ApprovalsRequired: args.ApprovalsRequired,
+			UserIDs:           userIDs,
+			GroupIDs:          groupIDs,
+		}
+		if args.Name != "" {
+			opt.Name = gitlab.Ptr(args.Name)
+		}
+		rule, _, err = util.GitlabClientFromContext(ctx).MergeRequestApprovals.UpdateApprovalRule(args.ProjectPath, mrIID, args.RuleID, opt, gitlab.WithContext(ctx))
+	}
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to %s approval rule: %v", args.Action, err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Approval rule %sd on merge request !%d:\n\n", args.Action, mrIID))
+	result.WriteString(formatApprovalRuleConfig(rule))
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// formatApprovalRuleConfig describes how a rule is set up, unlike
+// formatApprovalRules which reports approval progress
+func formatApprovalRuleConfig(rule *gitlab.MergeRequestApprovalRule) string {
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Rule ID: %d\n", rule.ID))
+	result.WriteString(fmt.Sprintf("Name: %s\n", rule.Name))
+	result.WriteString(fmt.Sprintf("Type: %s\n", rule.RuleType))
+	result.WriteString(fmt.Sprintf("Approvals Required: %d\n", rule.ApprovalsRequired))
+	if len(rule.Users) > 0 {
+		users := make([]string, 0, len(rule.Users))
+		for _, user := range rule.Users {
+			users = append(users, user.Username)
+		}
+		result.WriteString(fmt.Sprintf("Users: %s\n", strings.Join(users, ", ")))
+	}
+	if len(rule.Groups) > 0 {
+		groups := make([]string, 0, len(rule.Groups))
+		for _, group := range rule.Groups {
+			groups = append(groups, fmt.Sprintf("%s (ID: %d)", group.FullPath, group.ID))
+		}
+		result.WriteString(fmt.Sprintf("Groups: %s\n", strings.Join(groups, ", ")))
+	}
+	if rule.ContainsHiddenGroups {
+		result.WriteString("Contains groups you can't see\n")
+	}
+	result.WriteString("\n")
+	return result.String()
+}
+
+func getMRByURLHandler(ctx context.Context, request mcp.CallToolRequest, args GetMRByURLArgs) (*mcp.CallToolResult, error) {
+	projectPath, mrIID, err := parseMergeRequestURL(args.URL)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid merge request url: %v", err)), nil
+	}
+
+	return getMergeRequestHandler(ctx, request, GetMergeRequestArgs{
+		ProjectPath: projectPath,
+		MrIID:       mrIID,
+	})
+}
+
+// Helper function to extract the project path and IID from a merge request web URL
+func parseMergeRequestURL(rawURL string) (string, string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", "", err
+	}
+
+	path := strings.Trim(parsed.Path, "/")
+	projectPath, rest, found := strings.Cut(path, "/-/merge_requests/")
+	if !found {
+		// Older GitLab versions omit the "/-/" separator
+		projectPath, rest, found = strings.Cut(path, "/merge_requests/")
+	}
+	if !found || projectPath == "" {
+		return "", "", fmt.Errorf("%s does not look like a merge request URL", rawURL)
+	}
+
+	// Drop trailing segments such as /diffs or /commits
+	mrIID, _, _ := strings.Cut(rest, "/")
+	if _, err := strconv.Atoi(mrIID); err != nil {
+		return "", "", fmt.Errorf("invalid merge request IID %q", mrIID)
+	}
+
+	return projectPath, mrIID, nil
+}
+
+func getMRChangedFilesHandler(ctx context.Context, request mcp.CallToolRequest, args GetMergeRequestArgs) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(args.MrIID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
+	}
+
+	opt := &gitlab.ListMergeRequestDiffsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+	changes, err := util.AllPages(&opt.ListOptions, func() ([]*gitlab.MergeRequestDiff, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).MergeRequests.ListMergeRequestDiffs(args.ProjectPath, mrIID, opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request changes: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Changed files for Merge Request !%d (%d files):\n\n", mrIID, len(changes)))
+
+	totalAdded, totalDeleted := 0, 0
+	for _, change := range changes {
+		added, deleted := countDiffLines(change.Diff)
+		totalAdded += added
+		totalDeleted += deleted
+
+		switch {
+		case change.NewFile:
+			result.WriteString(fmt.Sprintf("A  %s (+%d -%d)\n", change.NewPath, added, deleted))
+		case change.DeletedFile:
+			result.WriteString(fmt.Sprintf("D  %s (+%d -%d)\n", change.OldPath, added, deleted))
+		case change.RenamedFile:
+			result.WriteString(fmt.Sprintf("R  %s -> %s (+%d -%d)\n", change.OldPath, change.NewPath, added, deleted))
+		default:
+			result.WriteString(fmt.Sprintf("M  %s (+%d -%d)\n", change.NewPath, added, deleted))
+		}
+	}
+
+	result.WriteString(fmt.Sprintf("\nTotal: +%d -%d\n", totalAdded, totalDeleted))
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// Helper function to count added and deleted lines in a GitLab diff (hunks only, no file headers)
+func countDiffLines(diff string) (int, int) {
+	added, deleted := 0, 0
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			added++
+		case strings.HasPrefix(line, "-"):
+			deleted++
+		}
+	}
+	return added, deleted
+}
+
+// Polling settings for rebase_and_merge
+const (
+	rebasePollInterval = 2 * time.Second
+	rebasePollTimeout  = 5 * time.Minute
+)
+
+// Rebase an MR, wait for GitLab to finish the rebase, then accept it
+func rebaseAndMergeHandler(ctx context.Context, request mcp.CallToolRequest, skipCI bool, args AcceptMergeRequestArgs) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(args.MrIID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
+	}
+
+	// A server-side rebase rewrites the commits without signatures, so the
+	// merge would always be refused after the branch was already rewritten
+	if args.RequireSigned {
+		return mcp.NewToolResultError("require_signed can't be combined with rebase_and_merge: GitLab's rebase drops commit signatures. Rebase and sign the commits locally, then use the accept action"), nil
+	}
+
+	_, err = util.GitlabClientFromContext(ctx).MergeRequests.RebaseMergeRequest(args.ProjectPath, mrIID, &gitlab.RebaseMergeRequestOptions{
+		SkipCI: &skipCI,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to rebase merge request: %v", err)), nil
+	}
+
+	// Rebase runs asynchronously, so poll until it is no longer in progress
+	deadline := time.Now().Add(rebasePollTimeout)
+	for {
+		select {
+		case <-ctx.Done():
+			return mcp.NewToolResultError(fmt.Sprintf("rebase of merge request !%d was not confirmed before the request was canceled; not merging", mrIID)), nil
+		case <-time.After(rebasePollInterval):
+		}
+
+		mr, _, err := util.GitlabClientFromContext(ctx).MergeRequests.GetMergeRequest(args.ProjectPath, mrIID, &gitlab.GetMergeRequestsOptions{
+			IncludeRebaseInProgress: gitlab.Ptr(true),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to check rebase status: %v", err)), nil
+		}
+
+		if !mr.RebaseInProgress {
+			if mr.MergeError != "" {
+				return mcp.NewToolResultError(fmt.Sprintf("rebase of merge request !%d failed, not merging: %s", mrIID, mr.MergeError)), nil
+			}
+			break
+		}
+
+		if time.Now().After(deadline) {
+			return mcp.NewToolResultError(fmt.Sprintf("rebase of merge request !%d still in progress after %s; not merging", mrIID, rebasePollTimeout)), nil
+		}
+	}
+
+	return acceptMergeRequestHandler(ctx, request, args)
+}
+
+func canMergeHandler(ctx context.Context, request mcp.CallToolRequest, args CanMergeArgs) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(args.MrIID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
+	}
+
+	client, err := util.GitlabClientFor(ctx, args.Identity)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	user, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get current user: %v", err)), nil
+	}
+
+	mr, _, err := client.MergeRequests.GetMergeRequest(args.ProjectPath, mrIID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request: %v", err)), nil
+	}
+
+	var blockers []string
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Merge check for !%d: %s\n", mr.IID, mr.Title))
+	result.WriteString(fmt.Sprintf("User: %s\n", user.Username))
+	result.WriteString(fmt.Sprintf("State: %s\n", mr.State))
+	if mr.State != "opened" {
+		blockers = append(blockers, fmt.Sprintf("merge request is %s", mr.State))
+	}
+
+	// Inherited membership covers access granted through parent groups
+	accessLevel := gitlab.NoPermissions
+	member, _, err := client.ProjectMembers.GetInheritedProjectMember(args.ProjectPath, user.ID, gitlab.WithContext(ctx))
+	if err == nil {
+		accessLevel = member.AccessLevel
+	}
+	if user.IsAdmin {
+		result.WriteString("Project Access: Administrator\n")
+	} else if accessLevel == gitlab.NoPermissions {
+		result.WriteString("Project Access: not a member\n")
+	} else {
+		result.WriteString(fmt.Sprintf("Project Access: %s\n", getAccessLevelString(accessLevel)))
+	}
+
+	protected, resp, err := client.ProtectedBranches.GetProtectedBranch(args.ProjectPath, mr.TargetBranch, gitlab.WithContext(ctx))
+	switch {
+	case err == nil:
+		result.WriteString(fmt.Sprintf("Target Branch: %s (protected, merge allowed for: %s)\n", mr.TargetBranch, formatAccessLevel(protected.MergeAccessLevels)))
+		if !user.IsAdmin && !canMergeToProtectedBranch(protected.MergeAccessLevels, user.ID, accessLevel) {
+			blockers = append(blockers, fmt.Sprintf("no merge permission on protected branch %s", mr.TargetBranch))
+		}
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		result.WriteString(fmt.Sprintf("Target Branch: %s (not protected)\n", mr.TargetBranch))
+		if !user.IsAdmin && accessLevel < gitlab.DeveloperPermissions {
+			blockers = append(blockers, "Developer access or higher is required to merge")
+		}
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get target branch protection: %v", err)), nil
+	}
+
+	result.WriteString(fmt.Sprintf("Merge Status: %s\n", mr.DetailedMergeStatus))
+	if mr.DetailedMergeStatus != "mergeable" && mr.State == "opened" {
+		blockers = append(blockers, fmt.Sprintf("merge status is %s", mr.DetailedMergeStatus))
+	}
+
+	approvals, _, err := client.MergeRequestApprovals.GetConfiguration(args.ProjectPath, mrIID, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get merge request approvals: %v", err)), nil
+	}
+	result.WriteString(fmt.Sprintf("Approved: %v (%d of %d required approvals left)\n", approvals.Approved, approvals.ApprovalsLeft, approvals.ApprovalsRequired))
+	if !approvals.Approved {
+		blockers = append(blockers, fmt.Sprintf("%d more approval(s) required", approvals.ApprovalsLeft))
+	}
+
+	// GitLab's own answer for the current user, covering rules not checked above
+	result.WriteString(fmt.Sprintf("GitLab reports can_merge: %v\n", mr.User.CanMerge))
+	if !mr.User.CanMerge && len(blockers) == 0 {
+		blockers = append(blockers, "GitLab reports this user cannot merge")
+	}
+
+	if len(blockers) == 0 {
+		result.WriteString("\n✅ This token can merge the merge request.\n")
+		return mcp.NewToolResultText(result.String()), nil
+	}
+
+	result.WriteString("\n❌ This token cannot merge the merge request right now:\n")
+	for _, blocker := range blockers {
+		result.WriteString(fmt.Sprintf("- %s\n", blocker))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// canMergeToProtectedBranch reports whether a user with the given project
+// access level matches one of the protected branch merge rules.
+func canMergeToProtectedBranch(levels []*gitlab.BranchAccessDescription, userID int, accessLevel gitlab.AccessLevelValue) bool {
+	for _, level := range levels {
+		if level.UserID != 0 {
+			if level.UserID == userID {
+				return true
+			}
+			continue
+		}
+		if level.GroupID != 0 {
+			// Group-based rules can't be resolved without listing group members
+			continue
+		}
+		if level.AccessLevel != gitlab.NoPermissions && accessLevel >= level.AccessLevel {
+			return true
+		}
+	}
+	return false
+}
+
+func addToMergeTrain(ctx context.Context, projectPath, mrIIDStr string, squash *bool) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(mrIIDStr)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
+	}
+
+	opt := &gitlab.AddMergeRequestToMergeTrainOptions{
+		Squash: squash,
+	}
+
+	trains, _, err := util.GitlabClientFromContext(ctx).MergeTrains.AddMergeRequestToMergeTrain(projectPath, mrIID, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to add merge request to merge train: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("🚂 Merge request !%d added to the merge train\n\n", mrIID))
+
+	// The response lists the train cars in order, so the index is the position
+	for i, car := range trains {
+		if car.MergeRequest == nil || car.MergeRequest.IID != mrIID {
+			continue
+		}
+		result.WriteString(fmt.Sprintf("Target Branch: %s\n", car.TargetBranch))
+		result.WriteString(fmt.Sprintf("Position: %d of %d\n", i+1, len(trains)))
+		result.WriteString(fmt.Sprintf("Status: %s\n", car.Status))
+		if car.Pipeline != nil {
+			result.WriteString(fmt.Sprintf("Pipeline: #%d (%s)\n", car.Pipeline.ID, car.Pipeline.Status))
+		}
+		return mcp.NewToolResultText(result.String()), nil
+	}
+
+	result.WriteString(fmt.Sprintf("Merge train now has %d merge request(s)\n", len(trains)))
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func removeFromMergeTrain(ctx context.Context, projectPath, mrIIDStr string) (*mcp.CallToolResult, error) {
+	mrIID, err := strconv.Atoi(mrIIDStr)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid mr_iid: %v", err)), nil
+	}
+
+	if _, _, err := util.GitlabClientFromContext(ctx).MergeTrains.GetMergeRequestOnAMergeTrain(projectPath, mrIID, gitlab.WithContext(ctx)); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("merge request !%d is not on a merge train: %v", mrIID, err)), nil
+	}
+
+	// Cancelling auto-merge is how GitLab takes a merge request off its train
+	_, _, err = util.GitlabClientFromContext(ctx).MergeRequests.CancelMergeWhenPipelineSucceeds(projectPath, mrIID, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to remove merge request from merge train: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("✅ Merge request !%d removed from the merge train", mrIID)), nil
+}
@@ -0,0 +1,300 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestListMergeRequestsFollowsNextPage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			w.Header().Set("X-Next-Page", "2")
+			writeJSON(t, w, []map[string]any{
+				{"iid": 1, "title": "First page MR", "state": "opened", "author": map[string]any{"username": "alice"}, "created_at": "2025-01-01T10:00:00Z"},
+			})
+		case "2":
+			writeJSON(t, w, []map[string]any{
+				{"iid": 2, "title": "Second page MR", "state": "merged", "author": map[string]any{"username": "bob"}, "created_at": "2025-01-01T10:00:00Z"},
+			})
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	result, err := listMergeRequestsHandler(newTestContext(t, mux), mcp.CallToolRequest{}, ListMergeRequestsArgs{
+		ProjectPath: "group/project",
+	})
+	text := resultText(t, result, err)
+
+	for _, want := range []string{"MR #1: First page MR", "MR #2: Second page MR"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("result missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "Showing the first") {
+		t.Errorf("result should not be marked truncated:\n%s", text)
+	}
+}
+
+func TestListMergeRequestsCapsLastPage(t *testing.T) {
+	// 450 MRs on the first page and 100 on the last overshoot the cap of 500
+	mrs := func(from, count int) []map[string]any {
+		page := make([]map[string]any, 0, count)
+		for i := from; i < from+count; i++ {
+			page = append(page, map[string]any{"iid": i, "title": fmt.Sprintf("MR %d", i), "author": map[string]any{"username": "alice"}, "created_at": "2025-01-01T10:00:00Z"})
+		}
+		return page
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, mrs(451, 100))
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, mrs(1, 450))
+	})
+
+	result, err := listMergeRequestsHandler(newTestContext(t, mux), mcp.CallToolRequest{}, ListMergeRequestsArgs{
+		ProjectPath: "group/project",
+	})
+	text := resultText(t, result, err)
+
+	if got := strings.Count(text, "MR #"); got != maxListedMergeRequests {
+		t.Errorf("listed %d merge requests, want %d", got, maxListedMergeRequests)
+	}
+	if !strings.Contains(text, "Showing the first 500 merge requests") {
+		t.Errorf("result should be marked truncated:\n%s", text[len(text)-200:])
+	}
+}
+
+func TestUpdateMergeRequestSetsLabels(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /api/v4/projects/group%2Fproject/merge_requests/7", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Labels string `json:"labels"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+			return
+		}
+		if body.Labels != "bug,urgent" {
+			t.Errorf("labels sent as %q, want %q", body.Labels, "bug,urgent")
+		}
+
+		writeJSON(t, w, map[string]any{
+			"iid":        7,
+			"title":      "Fix crash",
+			"state":      "opened",
+			"labels":     strings.Split(body.Labels, ","),
+			"author":     map[string]any{"username": "alice"},
+			"updated_at": "2025-01-01T10:00:00Z",
+		})
+	})
+
+	result, err := updateMergeRequestHandler(newTestContext(t, mux), mcp.CallToolRequest{}, UpdateMergeRequestArgs{
+		ProjectPath: "group/project",
+		MrIID:       "7",
+		Labels:      "bug,urgent",
+	})
+	text := resultText(t, result, err)
+
+	if !strings.Contains(text, "Labels: bug, urgent") {
+		t.Errorf("result should list both labels:\n%s", text)
+	}
+}
+
+func TestListMergeRequestsFiltersByLabels(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("labels"); got != "bug,urgent" {
+			t.Errorf("labels filter sent as %q, want %q", got, "bug,urgent")
+		}
+		writeJSON(t, w, []map[string]any{})
+	})
+
+	result, err := listMergeRequestsHandler(newTestContext(t, mux), mcp.CallToolRequest{}, ListMergeRequestsArgs{
+		ProjectPath: "group/project",
+		Labels:      " bug , urgent ,",
+	})
+	resultText(t, result, err)
+}
+
+func TestChangedFilesListsEveryPage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/merge_requests/5/diffs", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, []map[string]any{{"old_path": "late.go", "new_path": "late.go", "diff": "@@ -1 +1 @@\n-a\n+b\n"}})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{{"old_path": "early.go", "new_path": "early.go", "new_file": true, "diff": "@@ -0,0 +1 @@\n+a\n"}})
+	})
+
+	result, err := getMRChangedFilesHandler(newTestContext(t, mux), mcp.CallToolRequest{}, GetMergeRequestArgs{
+		ProjectPath: "group/project",
+		MrIID:       "5",
+	})
+	text := resultText(t, result, err)
+
+	for _, want := range []string{"(2 files)", "A  early.go", "M  late.go"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("result missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestMergeRequestSummaryCountsEveryDiffPage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"iid": 5, "title": "Big change", "state": "opened", "author": map[string]any{"username": "alice"}, "created_at": "2025-01-01T10:00:00Z"})
+	})
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/merge_requests/5/diffs", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, []map[string]any{{"old_path": "late.go", "new_path": "late.go", "diff": "@@ -1 +1 @@\n-a\n+b\n"}})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{{"old_path": "early.go", "new_path": "early.go", "new_file": true, "diff": "@@ -0,0 +1,2 @@\n+a\n+b\n"}})
+	})
+
+	result, err := getMergeRequestHandler(newTestContext(t, mux), mcp.CallToolRequest{}, GetMergeRequestArgs{
+		ProjectPath: "group/project",
+		MrIID:       "5",
+		SummaryOnly: true,
+	})
+	text := resultText(t, result, err)
+
+	for _, want := range []string{"Total files changed: 2", "late.go", "Total: +3/-1"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("result missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestApprovalsFindsApprovalNotesOnLaterPages(t *testing.T) {
+	base := "/api/v4/projects/group%2Fproject"
+	mux := http.NewServeMux()
+	mux.HandleFunc(base+"/merge_requests/3", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"iid": 3, "title": "Feature", "sha": "head123", "created_at": "2025-01-01T10:00:00Z"})
+	})
+	mux.HandleFunc(base+"/merge_requests/3/approvals", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"approved": true, "approved_by": []map[string]any{{"user": map[string]any{"username": "alice"}}}})
+	})
+	mux.HandleFunc(base+"/approvals", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"reset_approvals_on_push": false})
+	})
+	mux.HandleFunc(base+"/merge_requests/3/approval_state", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"rules": []any{}})
+	})
+	mux.HandleFunc(base+"/merge_requests/3/versions", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, []map[string]any{{"head_commit_sha": "head123", "created_at": "2025-01-02T10:00:00Z"}})
+	})
+	mux.HandleFunc(base+"/merge_requests/3/notes", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, []map[string]any{
+				{"id": 1, "system": true, "body": "approved this merge request", "author": map[string]any{"username": "alice"}, "created_at": "2025-01-01T12:00:00Z"},
+			})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{
+			{"id": 2, "system": false, "body": "looks good", "author": map[string]any{"username": "bob"}, "created_at": "2025-01-03T12:00:00Z"},
+		})
+	})
+
+	result, err := getMRApprovalsHandler(newTestContext(t, mux), mcp.CallToolRequest{}, GetMRApprovalsArgs{
+		ProjectPath: "group/project",
+		MrIID:       "3",
+	})
+	text := resultText(t, result, err)
+
+	if !strings.Contains(text, "- alice (approved 2025-01-01 12:00:00, before head SHA was pushed)") {
+		t.Errorf("approval on the second notes page not found:\n%s", text)
+	}
+}
+
+func TestManageMergeRequestsListWithApprovalState(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("state"); got != "opened" {
+			t.Errorf("state sent as %q, want %q", got, "opened")
+		}
+		writeJSON(t, w, []map[string]any{
+			{"id": 101, "iid": 1, "project_id": 42, "title": "Ready to merge", "state": "opened", "author": map[string]any{"username": "alice"}, "created_at": "2025-01-01T10:00:00Z"},
+			{"id": 102, "iid": 2, "project_id": 42, "title": "Still in review", "state": "opened", "author": map[string]any{"username": "bob"}, "created_at": "2025-01-01T10:00:00Z"},
+		})
+	})
+	mux.HandleFunc("/api/v4/projects/42/merge_requests/1/approvals", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"iid": 1, "approved": true})
+	})
+	mux.HandleFunc("/api/v4/projects/42/merge_requests/2/approvals", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"iid": 2, "approved": false})
+	})
+
+	args := MergeRequestManagementArgs{Action: "list", ProjectPath: "group/project"}
+	args.ListOptions.ApprovalState = "approved"
+	result, err := mergeRequestManagementHandler(newTestContext(t, mux), mcp.CallToolRequest{}, args)
+	text := resultText(t, result, err)
+
+	if !strings.Contains(text, "MR #1: Ready to merge") {
+		t.Errorf("result missing the approved MR:\n%s", text)
+	}
+	if strings.Contains(text, "Still in review") {
+		t.Errorf("result should not list the unapproved MR:\n%s", text)
+	}
+}
+
+func TestRebaseAndMergeRefusesRequireSigned(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	result, err := rebaseAndMergeHandler(newTestContext(t, mux), mcp.CallToolRequest{}, false, AcceptMergeRequestArgs{
+		ProjectPath:   "group/project",
+		MrIID:         "3",
+		RequireSigned: true,
+	})
+	if err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	if !result.IsError {
+		t.Fatalf("expected an error result, got:\n%s", toolResultText(result))
+	}
+}
+
+func TestListMRDiscussionsReadsEveryPage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/merge_requests/3/discussions", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, []map[string]any{{"id": "thread1", "individual_note": false, "notes": []map[string]any{
+				{"id": 2, "body": "Please rename this", "author": map[string]any{"username": "bob"}},
+			}}})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{{"id": "note1", "individual_note": true, "notes": []map[string]any{
+			{"id": 1, "body": "LGTM", "author": map[string]any{"username": "alice"}},
+		}}})
+	})
+
+	result, err := listMRDiscussions(newTestContext(t, mux), MergeRequestCommentsArgs{
+		Action:      "list_discussions",
+		ProjectPath: "group/project",
+		MrIID:       "3",
+	})
+	text := resultText(t, result, err)
+
+	if !strings.Contains(text, "Discussion ID: thread1") || strings.Contains(text, "note1") {
+		t.Errorf("result should list only the thread from the second page:\n%s", text)
+	}
+}
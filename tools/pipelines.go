@@ -1,9 +1,17 @@
 package tools
 
 import (
+	"archive/zip"
+	"bytes"
+	"cmp"
 	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"path"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -14,7 +22,7 @@ import (
 // Consolidated pipeline management arguments with action-based routing
 type PipelineManagementArgs struct {
 	ProjectPath string `json:"project_path" validate:"required,min=1"`
-	Action      string `json:"action" validate:"required,oneof=list get trigger"`
+	Action      string `json:"action" validate:"required,oneof=list get trigger download_artifacts"`
 	Confirmed   bool   `json:"confirmed,omitempty"`
 	
 	// List action options
@@ -24,7 +32,8 @@ type PipelineManagementArgs struct {
 	
 	// Get action options
 	GetOptions struct {
-		PipelineID float64 `json:"pipeline_id" validate:"required,min=1"`
+		PipelineID        float64 `json:"pipeline_id" validate:"required,min=1"`
+		IncludeJobTimings bool    `json:"include_job_timings,omitempty"`
 	} `json:"get_options,omitempty"`
 	
 	// Trigger action options
@@ -35,15 +44,44 @@ type PipelineManagementArgs struct {
 			Description string `json:"description,omitempty" validate:"omitempty,max=500"`
 			Source      string `json:"source,omitempty" validate:"omitempty,max=100"`
 		} `json:"metadata,omitempty"`
+		Wait           bool `json:"wait,omitempty"`
+		TimeoutSeconds int  `json:"timeout_seconds,omitempty" validate:"omitempty,min=1,max=3600"`
 	} `json:"trigger_options,omitempty"`
+	
+	// Download artifacts action options
+	ArtifactsOptions struct {
+		PipelineID float64 `json:"pipeline_id" validate:"required,min=1"`
+		MaxSizeMB  float64 `json:"max_size_mb,omitempty" validate:"omitempty,min=1"`
+	} `json:"artifacts_options,omitempty"`
+}
+
+type BulkTriggerArgs struct {
+	ProjectPaths []string          `json:"project_paths" validate:"required,min=1,dive,min=1"`
+	Ref          string            `json:"ref" validate:"required,min=1"`
+	Variables    map[string]string `json:"variables,omitempty" validate:"omitempty,dive,keys,min=1,endkeys,min=1"`
+	Confirmed    bool              `json:"confirmed,omitempty"`
+}
+
+type MergedCIConfigArgs struct {
+	ProjectPath string `json:"project_path" validate:"required,min=1"`
+	Ref         string `json:"ref,omitempty" validate:"omitempty,min=1"`
 }
 
+// Default cap on the combined size of artifacts gathered for a pipeline
+const defaultArtifactsMaxSizeMB = 10
+
+// Polling settings for trigger with wait
+const (
+	pipelinePollInterval   = 10 * time.Second
+	defaultPipelineTimeout = 10 * time.Minute
+)
+
 func RegisterPipelineTools(s *server.MCPServer) {
 	// Consolidated pipeline management tool
 	pipelineManagementTool := mcp.NewTool("manage_pipelines",
-		mcp.WithDescription("Comprehensive pipeline management for GitLab projects. Supports list, get details, and trigger operations."),
+		mcp.WithDescription("Comprehensive pipeline management for GitLab projects. Supports list, get details, trigger, and download artifacts operations."),
 		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
-		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: 'list' (list pipelines), 'get' (get pipeline details), 'trigger' (create new pipeline)")),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: 'list' (list pipelines), 'get' (get pipeline details), 'trigger' (create new pipeline), 'download_artifacts' (gather all job artifacts of a pipeline into one zip)")),
 		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for trigger action")),
 		
 		// List options
@@ -66,6 +104,10 @@ func RegisterPipelineTools(s *server.MCPServer) {
 					"type":        "number",
 					"description": "Pipeline ID to retrieve details for",
 				},
+				"include_job_timings": map[string]any{
+					"type":        "boolean",
+					"description": "Include per-stage durations and the longest jobs",
+				},
 			}),
 		),
 		
@@ -81,6 +123,16 @@ func RegisterPipelineTools(s *server.MCPServer) {
 					"type":        "object",
 					"description": "Optional variables to pass to the pipeline (key-value pairs)",
 				},
+				"wait": map[string]any{
+					"type":        "boolean",
+					"description": "Wait for the pipeline to finish and report its final status and failed jobs",
+				},
+				"timeout_seconds": map[string]any{
+					"type":        "integer",
+					"description": "How long to wait when wait is set (1-3600, default: 600)",
+					"minimum":     1,
+					"maximum":     3600,
+				},
 				"metadata": map[string]any{
 					"type": "object",
 					"description": "Additional pipeline metadata",
@@ -97,21 +149,54 @@ func RegisterPipelineTools(s *server.MCPServer) {
 				},
 			}),
 		),
+		
+		// Download artifacts options
+		mcp.WithObject("artifacts_options",
+			mcp.Description("Options for download_artifacts action"),
+			mcp.Properties(map[string]any{
+				"pipeline_id": map[string]any{
+					"type":        "number",
+					"description": "Pipeline ID to gather artifacts from",
+				},
+				"max_size_mb": map[string]any{
+					"type":        "number",
+					"description": "Maximum combined extracted size of the artifacts in MB (default: 10)",
+				},
+			}),
+		),
 	)
 	
+	// Merged CI configuration tool
+	mergedCIConfigTool := mcp.NewTool("get_merged_ci_config",
+		mcp.WithDescription("Get the fully resolved CI/CD configuration for a ref, with all includes merged, using the CI lint API"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
+		mcp.WithString("ref", mcp.Description("Branch, tag, or commit SHA whose .gitlab-ci.yml is resolved (default: project default branch)")),
+	)
+
+	// Bulk pipeline trigger tool
+	bulkTriggerTool := mcp.NewTool("bulk_trigger",
+		mcp.WithDescription("Trigger a pipeline on the same ref across multiple projects, reporting the pipeline or error for each project"),
+		mcp.WithArray("project_paths", mcp.Required(), mcp.Description("List of project/repo paths to trigger pipelines in"), mcp.Items(map[string]any{"type": "string"})),
+		mcp.WithString("ref", mcp.Required(), mcp.Description("Branch, tag, or commit SHA to trigger pipelines on")),
+		mcp.WithObject("variables", mcp.Description("Optional variables to pass to every pipeline (key-value pairs)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required to trigger the pipelines")),
+	)
+
 	s.AddTool(pipelineManagementTool, mcp.NewTypedToolHandler(pipelineManagementHandler))
+	s.AddTool(bulkTriggerTool, mcp.NewTypedToolHandler(bulkTriggerHandler))
+	s.AddTool(mergedCIConfigTool, mcp.NewTypedToolHandler(mergedCIConfigHandler))
 }
 
 // Consolidated pipeline management handler
 func pipelineManagementHandler(ctx context.Context, request mcp.CallToolRequest, args PipelineManagementArgs) (*mcp.CallToolResult, error) {
 	switch strings.ToLower(args.Action) {
 	case "list":
-		return handleListPipelines(args)
+		return handleListPipelines(ctx, args)
 	case "get":
 		if args.GetOptions.PipelineID == 0 {
 			return mcp.NewToolResultError("pipeline_id is required in get_options for get action"), nil
 		}
-		return handleGetPipeline(args)
+		return handleGetPipeline(ctx, args)
 	case "trigger":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with triggering a pipeline."), nil
@@ -119,14 +204,19 @@ func pipelineManagementHandler(ctx context.Context, request mcp.CallToolRequest,
 		if args.TriggerOptions.Ref == "" {
 			return mcp.NewToolResultError("ref is required in trigger_options for trigger action"), nil
 		}
-		return handleTriggerPipeline(args)
+		return handleTriggerPipeline(ctx, args)
+	case "download_artifacts":
+		if args.ArtifactsOptions.PipelineID == 0 {
+			return mcp.NewToolResultError("pipeline_id is required in artifacts_options for download_artifacts action"), nil
+		}
+		return handleDownloadPipelineArtifacts(ctx, args)
 	default:
-		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list, get, trigger", args.Action)), nil
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list, get, trigger, download_artifacts", args.Action)), nil
 	}
 }
 
 // Handle list pipelines action
-func handleListPipelines(args PipelineManagementArgs) (*mcp.CallToolResult, error) {
+func handleListPipelines(ctx context.Context, args PipelineManagementArgs) (*mcp.CallToolResult, error) {
 	opt := &gitlab.ListProjectPipelinesOptions{}
 	
 	status := "all"
@@ -138,7 +228,7 @@ func handleListPipelines(args PipelineManagementArgs) (*mcp.CallToolResult, erro
 		opt.Status = gitlab.Ptr(gitlab.BuildStateValue(status))
 	}
 
-	pipelines, _, err := util.GitlabClient().Pipelines.ListProjectPipelines(args.ProjectPath, opt)
+	pipelines, _, err := util.GitlabClientFromContext(ctx).Pipelines.ListProjectPipelines(args.ProjectPath, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to list pipelines: %v", err)), nil
 	}
@@ -163,10 +253,10 @@ func handleListPipelines(args PipelineManagementArgs) (*mcp.CallToolResult, erro
 }
 
 // Handle get pipeline details action
-func handleGetPipeline(args PipelineManagementArgs) (*mcp.CallToolResult, error) {
+func handleGetPipeline(ctx context.Context, args PipelineManagementArgs) (*mcp.CallToolResult, error) {
 	pipelineID := int(args.GetOptions.PipelineID)
 
-	pipeline, _, err := util.GitlabClient().Pipelines.GetPipeline(args.ProjectPath, pipelineID)
+	pipeline, _, err := util.GitlabClientFromContext(ctx).Pipelines.GetPipeline(args.ProjectPath, pipelineID, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get pipeline: %v", err)), nil
 	}
@@ -191,28 +281,102 @@ func handleGetPipeline(args PipelineManagementArgs) (*mcp.CallToolResult, error)
 	result.WriteString(fmt.Sprintf("Coverage: %s\n", pipeline.Coverage))
 	result.WriteString(fmt.Sprintf("URL: %s\n", pipeline.WebURL))
 
+	if args.GetOptions.IncludeJobTimings {
+		opt := &gitlab.ListJobsOptions{
+			ListOptions: gitlab.ListOptions{
+				PerPage: util.DefaultPerPage(100),
+			},
+		}
+		jobs, err := util.AllPages(&opt.ListOptions, func() ([]*gitlab.Job, *gitlab.Response, error) {
+			return util.GitlabClientFromContext(ctx).Jobs.ListPipelineJobs(args.ProjectPath, pipelineID, opt, gitlab.WithContext(ctx))
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to list pipeline jobs: %v", err)), nil
+		}
+		result.WriteString("\n")
+		result.WriteString(formatJobTimings(jobs))
+	}
+
 	return mcp.NewToolResultText(result.String()), nil
 }
 
+// Number of slowest jobs shown in the timing breakdown
+const slowestJobsLimit = 5
+
+// Helper function to summarize where pipeline time was spent, per stage and per job
+func formatJobTimings(jobs []*gitlab.Job) string {
+	var result strings.Builder
+
+	if len(jobs) == 0 {
+		result.WriteString("No jobs found for this pipeline.\n")
+		return result.String()
+	}
+
+	// Stage wall time runs from its first job start to its last job finish
+	type stageTiming struct {
+		start, finish *time.Time
+		jobs          int
+	}
+	var stages []string
+	timings := make(map[string]*stageTiming)
+	for _, job := range jobs {
+		timing, ok := timings[job.Stage]
+		if !ok {
+			timing = &stageTiming{}
+			timings[job.Stage] = timing
+			stages = append(stages, job.Stage)
+		}
+		timing.jobs++
+		if job.StartedAt != nil && (timing.start == nil || job.StartedAt.Before(*timing.start)) {
+			timing.start = job.StartedAt
+		}
+		if job.FinishedAt != nil && (timing.finish == nil || job.FinishedAt.After(*timing.finish)) {
+			timing.finish = job.FinishedAt
+		}
+	}
+
+	// Jobs are returned newest first, so list stages in execution order
+	slices.Reverse(stages)
+
+	result.WriteString("Stage Durations:\n")
+	for _, stage := range stages {
+		timing := timings[stage]
+		if timing.start == nil || timing.finish == nil {
+			result.WriteString(fmt.Sprintf("- %s: not finished (%d jobs)\n", stage, timing.jobs))
+			continue
+		}
+		result.WriteString(fmt.Sprintf("- %s: %.0f seconds (%d jobs)\n", stage, timing.finish.Sub(*timing.start).Seconds(), timing.jobs))
+	}
+
+	sorted := slices.Clone(jobs)
+	slices.SortFunc(sorted, func(a, b *gitlab.Job) int {
+		return cmp.Compare(b.Duration, a.Duration)
+	})
+	if len(sorted) > slowestJobsLimit {
+		sorted = sorted[:slowestJobsLimit]
+	}
+
+	result.WriteString("\nLongest Jobs:\n")
+	for _, job := range sorted {
+		result.WriteString(fmt.Sprintf("- %s (#%d, stage %s): %.0f seconds, queued %.0f seconds, status %s\n",
+			job.Name, job.ID, job.Stage, job.Duration, job.QueuedDuration, job.Status))
+	}
+
+	return result.String()
+}
+
 // Handle trigger pipeline action
-func handleTriggerPipeline(args PipelineManagementArgs) (*mcp.CallToolResult, error) {
+func handleTriggerPipeline(ctx context.Context, args PipelineManagementArgs) (*mcp.CallToolResult, error) {
 	opt := &gitlab.CreatePipelineOptions{
 		Ref: gitlab.Ptr(args.TriggerOptions.Ref),
 	}
 
 	// Add variables if provided
 	if len(args.TriggerOptions.Variables) > 0 {
-		var variables []*gitlab.PipelineVariableOptions
-		for key, value := range args.TriggerOptions.Variables {
-			variables = append(variables, &gitlab.PipelineVariableOptions{
-				Key:   gitlab.Ptr(key),
-				Value: gitlab.Ptr(value),
-			})
-		}
-		opt.Variables = &variables
+		opt.Variables = pipelineVariables(args.TriggerOptions.Variables)
 	}
 
-	pipeline, _, err := util.GitlabClient().Pipelines.CreatePipeline(args.ProjectPath, opt)
+	pipeline, _, err := util.GitlabClientFromContext(ctx).Pipelines.CreatePipeline(args.ProjectPath, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to trigger pipeline: %v", err)), nil
 	}
@@ -241,5 +405,338 @@ func handleTriggerPipeline(args PipelineManagementArgs) (*mcp.CallToolResult, er
 		result.WriteString(fmt.Sprintf("Source: %s\n", args.TriggerOptions.Metadata.Source))
 	}
 
+	if args.TriggerOptions.Wait {
+		timeout := defaultPipelineTimeout
+		if args.TriggerOptions.TimeoutSeconds > 0 {
+			timeout = time.Duration(args.TriggerOptions.TimeoutSeconds) * time.Second
+		}
+		result.WriteString("\n")
+		result.WriteString(waitForPipeline(ctx, args.ProjectPath, pipeline.ID, timeout))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// pipelineFinished reports whether a pipeline status will not change without user action
+func pipelineFinished(status string) bool {
+	switch status {
+	case "success", "failed", "canceled", "skipped", "manual":
+		return true
+	}
+	return false
+}
+
+// waitForPipeline polls a pipeline until it finishes or the timeout elapses and
+// describes the outcome, including the names of failed jobs
+func waitForPipeline(ctx context.Context, projectPath string, pipelineID int, timeout time.Duration) string {
+	deadline := time.Now().Add(timeout)
+	for {
+		// Don't sleep past the deadline: the last poll happens when the timeout ends
+		wait := max(min(pipelinePollInterval, time.Until(deadline)), 0)
+		select {
+		case <-ctx.Done():
+			return fmt.Sprintf("⚠️ Stopped waiting for pipeline #%d: the request was canceled\n", pipelineID)
+		case <-time.After(wait):
+		}
+
+		pipeline, _, err := util.GitlabClientFromContext(ctx).Pipelines.GetPipeline(projectPath, pipelineID, gitlab.WithContext(ctx))
+		if err != nil {
+			return fmt.Sprintf("⚠️ Stopped waiting for pipeline #%d: failed to get pipeline: %v\n", pipelineID, err)
+		}
+
+		if pipelineFinished(pipeline.Status) {
+			return formatPipelineOutcome(ctx, projectPath, pipeline)
+		}
+
+		if !time.Now().Before(deadline) {
+			return fmt.Sprintf("⏳ Pipeline #%d is still %s after %s, check it again later\n", pipelineID, pipeline.Status, timeout)
+		}
+	}
+}
+
+func formatPipelineOutcome(ctx context.Context, projectPath string, pipeline *gitlab.Pipeline) string {
+	var result strings.Builder
+	switch pipeline.Status {
+	case "success":
+		result.WriteString(fmt.Sprintf("✅ Pipeline #%d succeeded", pipeline.ID))
+	case "manual":
+		result.WriteString(fmt.Sprintf("⏳ Pipeline #%d is waiting for a manual job", pipeline.ID))
+	default:
+		result.WriteString(fmt.Sprintf("🔴 Pipeline #%d finished with status %s", pipeline.ID, pipeline.Status))
+	}
+	if pipeline.Duration > 0 {
+		result.WriteString(fmt.Sprintf(" in %s", time.Duration(pipeline.Duration)*time.Second))
+	}
+	result.WriteString("\n")
+
+	if pipeline.Status != "failed" {
+		return result.String()
+	}
+
+	opt := &gitlab.ListJobsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: util.DefaultPerPage(100)},
+		Scope:       &[]gitlab.BuildStateValue{gitlab.Failed},
+	}
+	jobs, err := util.AllPages(&opt.ListOptions, func() ([]*gitlab.Job, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).Jobs.ListPipelineJobs(projectPath, pipeline.ID, opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		result.WriteString(fmt.Sprintf("Failed to list failed jobs: %v\n", err))
+		return result.String()
+	}
+
+	result.WriteString("Failed jobs:\n")
+	for _, job := range jobs {
+		note := ""
+		if job.AllowFailure {
+			note = " (allowed to fail)"
+		}
+		result.WriteString(fmt.Sprintf("- %s [%s]%s %s\n", job.Name, job.Stage, note, job.WebURL))
+	}
+	return result.String()
+}
+
+func pipelineVariables(vars map[string]string) *[]*gitlab.PipelineVariableOptions {
+	var variables []*gitlab.PipelineVariableOptions
+	for key, value := range vars {
+		variables = append(variables, &gitlab.PipelineVariableOptions{
+			Key:   gitlab.Ptr(key),
+			Value: gitlab.Ptr(value),
+		})
+	}
+	return &variables
+}
+
+func bulkTriggerHandler(ctx context.Context, request mcp.CallToolRequest, args BulkTriggerArgs) (*mcp.CallToolResult, error) {
+	if !args.Confirmed {
+		return mcp.NewToolResultError(fmt.Sprintf("This operation requires confirmation. Please set 'confirmed: true' to proceed with triggering pipelines in %d projects.", len(args.ProjectPaths))), nil
+	}
+
+	opt := &gitlab.CreatePipelineOptions{
+		Ref: gitlab.Ptr(args.Ref),
+	}
+	if len(args.Variables) > 0 {
+		opt.Variables = pipelineVariables(args.Variables)
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Bulk pipeline trigger on ref '%s':\n\n", args.Ref))
+
+	// Trigger sequentially so a failure in one project is reported without
+	// affecting the others.
+	failed := 0
+	for _, projectPath := range args.ProjectPaths {
+		pipeline, _, err := util.GitlabClientFromContext(ctx).Pipelines.CreatePipeline(projectPath, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			failed++
+			result.WriteString(fmt.Sprintf("❌ %s: %v\n", projectPath, err))
+			continue
+		}
+		result.WriteString(fmt.Sprintf("✅ %s: Pipeline #%d (%s) %s\n", projectPath, pipeline.ID, pipeline.Status, pipeline.WebURL))
+	}
+
+	result.WriteString(fmt.Sprintf("\nTriggered: %d, Failed: %d\n", len(args.ProjectPaths)-failed, failed))
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// Handle download pipeline artifacts action
+func handleDownloadPipelineArtifacts(ctx context.Context, args PipelineManagementArgs) (*mcp.CallToolResult, error) {
+	pipelineID := int(args.ArtifactsOptions.PipelineID)
+
+	maxSizeMB := defaultArtifactsMaxSizeMB
+	if args.ArtifactsOptions.MaxSizeMB > 0 {
+		maxSizeMB = int(args.ArtifactsOptions.MaxSizeMB)
+	}
+	maxBytes := int64(maxSizeMB) * 1024 * 1024
+
+	opt := &gitlab.ListJobsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+	jobs, err := util.AllPages(&opt.ListOptions, func() ([]*gitlab.Job, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).Jobs.ListPipelineJobs(args.ProjectPath, pipelineID, opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list pipeline jobs: %v", err)), nil
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	var totalSize int64
+	var included, skipped []string
+
+	for _, job := range jobs {
+		if job.ArtifactsFile.Filename == "" {
+			continue
+		}
+
+		// An archive never extracts to less than its compressed size, so skip
+		// jobs that can't fit before downloading them
+		if totalSize+int64(job.ArtifactsFile.Size) > maxBytes {
+			skipped = append(skipped, fmt.Sprintf("%s (job #%d, %d bytes compressed)", job.Name, job.ID, job.ArtifactsFile.Size))
+			continue
+		}
+
+		reader, _, err := util.GitlabClientFromContext(ctx).Jobs.GetJobArtifacts(args.ProjectPath, job.ID, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to download artifacts for job #%d: %v", job.ID, err)), nil
+		}
+
+		src, err := zip.NewReader(reader, reader.Size())
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to read artifacts for job #%d: %v", job.ID, err)), nil
+		}
+
+		// The limit applies to the extracted size, which is what the combined archive holds
+		extracted := extractedSize(src)
+		if totalSize+extracted > maxBytes {
+			skipped = append(skipped, fmt.Sprintf("%s (job #%d, %d bytes extracted)", job.Name, job.ID, extracted))
+			continue
+		}
+
+		dir := fmt.Sprintf("%s-%d", strings.ReplaceAll(job.Name, "/", "_"), job.ID)
+		if err := addJobArtifacts(archive, dir, src, extracted); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to read artifacts for job #%d: %v", job.ID, err)), nil
+		}
+
+		totalSize += extracted
+		included = append(included, fmt.Sprintf("%s (job #%d, %d bytes)", job.Name, job.ID, extracted))
+	}
+
+	if err := archive.Close(); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to build artifacts archive: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Artifacts for pipeline #%d in project %s:\n\n", pipelineID, args.ProjectPath))
+
+	if len(included) == 0 && len(skipped) == 0 {
+		result.WriteString("No artifacts found for this pipeline.\n")
+		return mcp.NewToolResultText(result.String()), nil
+	}
+
+	for _, job := range included {
+		result.WriteString(fmt.Sprintf("- %s\n", job))
+	}
+
+	if len(skipped) > 0 {
+		result.WriteString(fmt.Sprintf("\n⚠️ Truncated: the %d MB size limit was reached, so artifacts from these jobs were not included:\n", maxSizeMB))
+		for _, job := range skipped {
+			result.WriteString(fmt.Sprintf("- %s\n", job))
+		}
+		result.WriteString("Increase max_size_mb or download these jobs individually.\n")
+	}
+
+	if len(included) == 0 {
+		return mcp.NewToolResultText(result.String()), nil
+	}
+
+	return mcp.NewToolResultResource(result.String(), mcp.BlobResourceContents{
+		URI:      fmt.Sprintf("gitlab://%s/pipelines/%d/artifacts.zip", args.ProjectPath, pipelineID),
+		MIMEType: "application/zip",
+		Blob:     base64.StdEncoding.EncodeToString(buf.Bytes()),
+	}), nil
+}
+
+// extractedSize sums the uncompressed sizes the archive declares for its files
+func extractedSize(src *zip.Reader) int64 {
+	var size int64
+	for _, file := range src.File {
+		if !file.FileInfo().IsDir() {
+			size += int64(file.UncompressedSize64)
+		}
+	}
+	return size
+}
+
+// Helper function to copy a job's artifacts archive into the combined archive under its own directory.
+// At most limit bytes are extracted, so an archive that understates its sizes can't exceed the cap.
+func addJobArtifacts(archive *zip.Writer, dir string, src *zip.Reader, limit int64) error {
+	for _, file := range src.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		// Reject entries such as "../../etc/passwd" that would land outside the job's directory
+		name := path.Join(dir, file.Name)
+		if !strings.HasPrefix(name, dir+"/") {
+			return fmt.Errorf("artifact entry %q escapes the job directory", file.Name)
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return err
+		}
+
+		w, err := archive.Create(name)
+		if err != nil {
+			rc.Close()
+			return err
+		}
+
+		written, err := io.Copy(w, io.LimitReader(rc, limit+1))
+		rc.Close()
+		if err != nil {
+			return err
+		}
+		if limit -= written; limit < 0 {
+			return fmt.Errorf("artifact entry %q extracts to more than the archive declares", file.Name)
+		}
+	}
+
+	return nil
+}
+
+// Merged CI configuration handler
+func mergedCIConfigHandler(ctx context.Context, request mcp.CallToolRequest, args MergedCIConfigArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.ProjectLintOptions{}
+	if args.Ref != "" {
+		opt.ContentRef = gitlab.Ptr(args.Ref)
+	}
+
+	lint, _, err := util.GitlabClientFromContext(ctx).Validate.ProjectLint(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to resolve CI configuration: %v", err)), nil
+	}
+
+	ref := args.Ref
+	if ref == "" {
+		ref = "default branch"
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Merged CI configuration for %s (%s):\n\n", args.ProjectPath, ref))
+	result.WriteString(fmt.Sprintf("Valid: %v\n", lint.Valid))
+
+	if len(lint.Errors) > 0 {
+		result.WriteString("\nErrors:\n")
+		for _, e := range lint.Errors {
+			result.WriteString(fmt.Sprintf("- %s\n", e))
+		}
+	}
+
+	if len(lint.Warnings) > 0 {
+		result.WriteString("\nWarnings:\n")
+		for _, w := range lint.Warnings {
+			result.WriteString(fmt.Sprintf("- %s\n", w))
+		}
+	}
+
+	if len(lint.Includes) > 0 {
+		result.WriteString("\nIncludes:\n")
+		for _, include := range lint.Includes {
+			result.WriteString(fmt.Sprintf("- [%s] %s\n", include.Type, include.Location))
+		}
+	}
+
+	if lint.MergedYaml != "" {
+		result.WriteString("\nMerged YAML:\n")
+		result.WriteString("```yaml\n")
+		result.WriteString(lint.MergedYaml)
+		result.WriteString("\n```\n")
+	}
+
 	return mcp.NewToolResultText(result.String()), nil
-} 
\ No newline at end of file
+}
@@ -0,0 +1,75 @@
+package tools
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitForPipelineStopsAtTimeout(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/pipelines/4", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"id": 4, "status": "running"})
+	})
+
+	start := time.Now()
+	text := waitForPipeline(newTestContext(t, mux), "group/project", 4, 50*time.Millisecond)
+
+	if elapsed := time.Since(start); elapsed >= pipelinePollInterval {
+		t.Errorf("waited %s for a 50ms timeout", elapsed)
+	}
+	if !strings.Contains(text, "still running") {
+		t.Errorf("unexpected result: %s", text)
+	}
+}
+
+func TestWaitForPipelineListsFailedJobsFromEveryPage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/pipelines/4", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"id": 4, "status": "failed"})
+	})
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/pipelines/4/jobs", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, []map[string]any{{"id": 2, "name": "lint", "stage": "test"}})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{{"id": 1, "name": "unit", "stage": "test"}})
+	})
+
+	text := waitForPipeline(newTestContext(t, mux), "group/project", 4, 0)
+
+	for _, want := range []string{"- unit [test]", "- lint [test]"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("result missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestPipelineJobTimingsIncludeEveryPage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/pipelines/4", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"id": 4, "status": "success", "created_at": "2025-01-01T10:00:00Z", "updated_at": "2025-01-01T10:05:00Z"})
+	})
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/pipelines/4/jobs", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, []map[string]any{{"id": 1, "name": "compile", "stage": "build", "duration": 30}})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{{"id": 2, "name": "unit", "stage": "test", "duration": 60}})
+	})
+
+	args := PipelineManagementArgs{ProjectPath: "group/project", Action: "get"}
+	args.GetOptions.PipelineID = 4
+	args.GetOptions.IncludeJobTimings = true
+	result, err := handleGetPipeline(newTestContext(t, mux), args)
+	text := resultText(t, result, err)
+
+	for _, want := range []string{"- build: not finished (1 jobs)", "- compile (#1, stage build)"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("result missing %q:\n%s", want, text)
+		}
+	}
+}
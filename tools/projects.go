@@ -3,6 +3,9 @@ package tools
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -11,82 +14,313 @@ import (
 )
 
 type ListProjectsArgs struct {
-	GroupID string `json:"group_id" validate:"required,min=1"`
-	Search  string `json:"search" validate:"omitempty,min=1,max=200"`
+	GroupID           string `json:"group_id" validate:"omitempty,min=1"`
+	Search            string `json:"search" validate:"omitempty,min=1,max=200"`
+	Scope             string `json:"scope,omitempty" validate:"omitempty,oneof=membership owned starred"`
+	LastActivityAfter string `json:"last_activity_after,omitempty"`
+	Page              int    `json:"page,omitempty" validate:"omitempty,min=1"`
+	PerPage           int    `json:"per_page,omitempty" validate:"omitempty,min=1,max=100"`
 }
 
 type GetProjectArgs struct {
 	ProjectPath string `json:"project_path" validate:"required,min=1,max=500"`
+	Statistics  bool   `json:"statistics,omitempty"`
+}
+
+type GetProjectLanguagesArgs struct {
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=500"`
+}
+
+type GetProjectForksArgs struct {
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=500"`
+	Search      string `json:"search" validate:"omitempty,min=1,max=200"`
+}
+
+type ProjectMergeSettingsArgs struct {
+	Action                                    string `json:"action" validate:"required,oneof=get update"`
+	ProjectPath                               string `json:"project_path" validate:"required,min=1,max=500"`
+	OnlyAllowMergeIfPipelineSucceeds          *bool  `json:"only_allow_merge_if_pipeline_succeeds,omitempty"`
+	OnlyAllowMergeIfAllDiscussionsAreResolved *bool  `json:"only_allow_merge_if_all_discussions_are_resolved,omitempty"`
+	Confirmed                                 bool   `json:"confirmed,omitempty"`
+}
+
+type AuditProjectAccessArgs struct {
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=500"`
+}
+
+type CreateProjectArgs struct {
+	Name                 string `json:"name" validate:"required,min=1,max=255"`
+	Path                 string `json:"path,omitempty" validate:"omitempty,min=1,max=255"`
+	NamespaceID          int    `json:"namespace_id,omitempty" validate:"omitempty,min=1"`
+	Description          string `json:"description,omitempty" validate:"omitempty,max=2000"`
+	Visibility           string `json:"visibility,omitempty" validate:"omitempty,oneof=private internal public"`
+	DefaultBranch        string `json:"default_branch,omitempty" validate:"omitempty,min=1,max=255"`
+	InitializeWithReadme bool   `json:"initialize_with_readme,omitempty"`
+	Confirmed            bool   `json:"confirmed,omitempty"`
+}
+
+type ForkProjectArgs struct {
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=500"`
+	Namespace   string `json:"namespace,omitempty" validate:"omitempty,min=1,max=500"`
+	Name        string `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	Path        string `json:"path,omitempty" validate:"omitempty,min=1,max=255"`
+	Visibility  string `json:"visibility,omitempty" validate:"omitempty,oneof=private internal public"`
+	Confirmed   bool   `json:"confirmed,omitempty"`
+}
+
+type ProjectMembersArgs struct {
+	Action      string `json:"action" validate:"required,oneof=list add update remove"`
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=500"`
+	UserID      int    `json:"user_id,omitempty" validate:"required_unless=Action list,omitempty,min=1"`
+	AccessLevel string `json:"access_level,omitempty" validate:"omitempty,oneof=guest reporter developer maintainer owner"`
+	ExpiresAt   string `json:"expires_at,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	Search      string `json:"search,omitempty" validate:"omitempty,min=1,max=100"`
+	Confirmed   bool   `json:"confirmed,omitempty"`
 }
 
 func RegisterProjectTools(s *server.MCPServer) {
 	listProjectsTool := mcp.NewTool("list_projects",
-		mcp.WithDescription("List GitLab projects"),
-		mcp.WithString("group_id", mcp.Required(), mcp.Description("gitlab group ID")),
+		mcp.WithDescription("List GitLab projects in a group, or across all groups the user can see when no group is given"),
+		mcp.WithString("group_id", mcp.Description("gitlab group ID. When omitted, projects are listed across groups using scope")),
 		mcp.WithString("search", mcp.Description("Multiple terms can be provided, separated by an escaped space, either + or %20, and will be ANDed together. Example: one+two will match substrings one and two (in any order).")),
+		mcp.WithString("scope", mcp.Description("Which projects to list: membership (projects you are a member of, default without group_id; inside a group, projects you have any role in), owned, starred"), mcp.Enum("membership", "owned", "starred")),
+		mcp.WithString("last_activity_after", mcp.Description("Only projects with activity after this date: YYYY-MM-DD, today, yesterday, last week, last month, or an offset like 7d, 2w, 3mo")),
+		mcp.WithNumber("page", mcp.Description("Page of results to return (default: 1)")),
+		mcp.WithNumber("per_page", mcp.Description("Results per page (1-100, default: 100)")),
 	)
 
 	projectTool := mcp.NewTool("get_project",
 		mcp.WithDescription("Get GitLab project details"),
 		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
+		mcp.WithBoolean("statistics", mcp.Description("Include repository statistics such as repository size and commit count")),
+	)
+
+	projectLanguagesTool := mcp.NewTool("get_project_languages",
+		mcp.WithDescription("Get the language breakdown of a project's repository, as a percentage per language"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
+	)
+
+	projectForksTool := mcp.NewTool("get_project_forks",
+		mcp.WithDescription("List forks of a GitLab project"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
+		mcp.WithString("search", mcp.Description("Filter forks by name")),
+	)
+
+	projectMergeSettingsTool := mcp.NewTool("manage_project_merge_settings",
+		mcp.WithDescription("Read or change the project settings that decide whether a merge request can be merged (pipelines must succeed, all discussions resolved)"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: get, update")),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
+		mcp.WithBoolean("only_allow_merge_if_pipeline_succeeds", mcp.Description("Require a successful pipeline before merging (update action)")),
+		mcp.WithBoolean("only_allow_merge_if_all_discussions_are_resolved", mcp.Description("Require all discussions to be resolved before merging (update action)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for update action")),
+	)
+
+	auditProjectAccessTool := mcp.NewTool("audit_project_access",
+		mcp.WithDescription("Report who can access a project and how: visibility, archived state, members (including inherited) with access levels, and groups the project is shared with"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
+	)
+
+	createProjectTool := mcp.NewTool("create_project",
+		mcp.WithDescription("Create a new GitLab project"),
+		mcp.WithString("name", mcp.Required(), mcp.Description("Project name")),
+		mcp.WithString("path", mcp.Description("Repository path (defaults to a slug of the name)")),
+		mcp.WithNumber("namespace_id", mcp.Description("ID of the group or user namespace to create the project in (defaults to the token owner's namespace)")),
+		mcp.WithString("description", mcp.Description("Project description")),
+		mcp.WithString("visibility", mcp.Description("Visibility: private, internal, public (default: private)")),
+		mcp.WithString("default_branch", mcp.Description("Name of the default branch (requires initialize_with_readme to create it)")),
+		mcp.WithBoolean("initialize_with_readme", mcp.Description("Create an initial commit with a README so the default branch exists")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required to create the project")),
+	)
+
+	forkProjectTool := mcp.NewTool("fork_project",
+		mcp.WithDescription("Fork a GitLab project into another namespace"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path to fork")),
+		mcp.WithString("namespace", mcp.Description("Full path of the group or user namespace to fork into (defaults to the token owner's namespace)")),
+		mcp.WithString("name", mcp.Description("Name of the fork (defaults to the source project's name)")),
+		mcp.WithString("path", mcp.Description("Repository path of the fork (defaults to the source project's path)")),
+		mcp.WithString("visibility", mcp.Description("Visibility of the fork: private, internal, public")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required to fork the project")),
+	)
+
+	projectMembersTool := mcp.NewTool("manage_project_members",
+		mcp.WithDescription("Manage direct members of a project: list, add, update (access level or expiry), remove"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: list, add, update, remove")),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path")),
+		mcp.WithNumber("user_id", mcp.Description("User ID (required for add, update, remove)")),
+		mcp.WithString("access_level", mcp.Description("Access level: guest, reporter, developer, maintainer, owner (required for add; update keeps the current level when only expires_at is given)")),
+		mcp.WithString("expires_at", mcp.Description("Membership expiry date in YYYY-MM-DD (add and update)")),
+		mcp.WithString("search", mcp.Description("Filter members by name or username (list action)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for add, update and remove actions")),
 	)
 
 	s.AddTool(listProjectsTool, mcp.NewTypedToolHandler(listProjectsHandler))
 	s.AddTool(projectTool, mcp.NewTypedToolHandler(getProjectHandler))
+	s.AddTool(projectLanguagesTool, mcp.NewTypedToolHandler(getProjectLanguagesHandler))
+	s.AddTool(projectForksTool, mcp.NewTypedToolHandler(getProjectForksHandler))
+	s.AddTool(projectMergeSettingsTool, mcp.NewTypedToolHandler(projectMergeSettingsHandler))
+	s.AddTool(auditProjectAccessTool, mcp.NewTypedToolHandler(auditProjectAccessHandler))
+	s.AddTool(createProjectTool, mcp.NewTypedToolHandler(createProjectHandler))
+	s.AddTool(forkProjectTool, mcp.NewTypedToolHandler(forkProjectHandler))
+	s.AddTool(projectMembersTool, mcp.NewTypedToolHandler(projectMembersHandler))
 }
 
 func listProjectsHandler(ctx context.Context, request mcp.CallToolRequest, args ListProjectsArgs) (*mcp.CallToolResult, error) {
-	opt := &gitlab.ListGroupProjectsOptions{
-		Archived: gitlab.Ptr(false),
-		OrderBy:  gitlab.Ptr("last_activity_at"),
-		Sort:     gitlab.Ptr("desc"),
-		ListOptions: gitlab.ListOptions{
-			PerPage: 100,
-		},
+	perPage := args.PerPage
+	if perPage == 0 {
+		perPage = util.DefaultPerPage(100)
+	}
+	listOptions := gitlab.ListOptions{
+		PerPage: perPage,
+		Page:    args.Page,
 	}
 
-	if args.Search != "" {
-		opt.Search = gitlab.Ptr(args.Search)
+	var lastActivityAfter *time.Time
+	if args.LastActivityAfter != "" {
+		date, err := util.ResolveDate(args.LastActivityAfter, time.UTC)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid last_activity_after: %v", err)), nil
+		}
+		after, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid last_activity_after: %v", err)), nil
+		}
+		lastActivityAfter = &after
 	}
 
-	projects, _, err := util.GitlabClient().Groups.ListGroupProjects(args.GroupID, opt)
-	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to search projects: %v", err)), nil
+	var projects []*gitlab.Project
+	var resp *gitlab.Response
+	var err error
+	pastCutoff := false
+	if args.GroupID != "" {
+		opt := &gitlab.ListGroupProjectsOptions{
+			Archived:    gitlab.Ptr(false),
+			OrderBy:     gitlab.Ptr("last_activity_at"),
+			Sort:        gitlab.Ptr("desc"),
+			ListOptions: listOptions,
+		}
+		if args.Search != "" {
+			opt.Search = gitlab.Ptr(args.Search)
+		}
+		switch args.Scope {
+		case "membership":
+			// The group projects API has no membership flag; any role means the user is a member
+			opt.MinAccessLevel = gitlab.Ptr(gitlab.GuestPermissions)
+		case "owned":
+			opt.Owned = gitlab.Ptr(true)
+		case "starred":
+			opt.Starred = gitlab.Ptr(true)
+		}
+
+		projects, resp, err = util.GitlabClientFromContext(ctx).Groups.ListGroupProjects(args.GroupID, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to search projects: %v", err)), nil
+		}
+
+		// The group projects API has no activity filter, so apply it here. Projects
+		// come most recently active first, so once one is past the cutoff every
+		// later project is too and there is no next page worth fetching.
+		if lastActivityAfter != nil {
+			var filtered []*gitlab.Project
+			for _, project := range projects {
+				if project.LastActivityAt == nil || !project.LastActivityAt.After(*lastActivityAfter) {
+					pastCutoff = true
+					break
+				}
+				filtered = append(filtered, project)
+			}
+			projects = filtered
+		}
+	} else {
+		opt := &gitlab.ListProjectsOptions{
+			Archived:          gitlab.Ptr(false),
+			OrderBy:           gitlab.Ptr("last_activity_at"),
+			Sort:              gitlab.Ptr("desc"),
+			LastActivityAfter: lastActivityAfter,
+			ListOptions:       listOptions,
+		}
+		if args.Search != "" {
+			opt.Search = gitlab.Ptr(args.Search)
+		}
+		switch args.Scope {
+		case "owned":
+			opt.Owned = gitlab.Ptr(true)
+		case "starred":
+			opt.Starred = gitlab.Ptr(true)
+		default:
+			// Without a group, listing every visible project is rarely what's wanted
+			opt.Membership = gitlab.Ptr(true)
+		}
+
+		projects, resp, err = util.GitlabClientFromContext(ctx).Projects.ListProjects(opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to list projects: %v", err)), nil
+		}
 	}
 
-	var result string
+	var result strings.Builder
+	if len(projects) == 0 {
+		result.WriteString("No projects found.\n")
+	}
 	for _, project := range projects {
-		result += fmt.Sprintf("ID: %d\nName: %s\nPath: %s\nDescription: %s\nLast Activity: %s\n\n",
-			project.ID, project.Name, project.PathWithNamespace, project.Description, project.LastActivityAt.Format("2006-01-02 15:04:05"))
+		lastActivity := "unknown"
+		if project.LastActivityAt != nil {
+			lastActivity = project.LastActivityAt.Format("2006-01-02 15:04:05")
+		}
+		result.WriteString(fmt.Sprintf("ID: %d\nName: %s\nPath: %s\nDescription: %s\nLast Activity: %s\n\n",
+			project.ID, project.Name, project.PathWithNamespace, project.Description, lastActivity))
 	}
 
-	return mcp.NewToolResultText(result), nil
+	if resp.NextPage != 0 && !pastCutoff {
+		result.WriteString(fmt.Sprintf("More projects available. Use page=%d to fetch the next page.\n", resp.NextPage))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
 }
 
 func getProjectHandler(ctx context.Context, request mcp.CallToolRequest, args GetProjectArgs) (*mcp.CallToolResult, error) {
 	// Get project details
-	project, _, err := util.GitlabClient().Projects.GetProject(args.ProjectPath, nil)
+	opt := &gitlab.GetProjectOptions{}
+	if args.Statistics {
+		opt.Statistics = gitlab.Ptr(true)
+	}
+	project, resp, err := util.GitlabClientFromContext(ctx).Projects.GetProject(args.ProjectPath, opt, gitlab.WithContext(ctx))
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to get project: %v", err)), nil
+		return mcp.NewToolResultError(util.ResponseError("get project", fmt.Sprintf("project %s", args.ProjectPath), resp, err)), nil
 	}
 
 	// Get branches
-	branches, _, err := util.GitlabClient().Branches.ListBranches(args.ProjectPath, nil)
+	branches, _, err := util.GitlabClientFromContext(ctx).Branches.ListBranches(args.ProjectPath, nil, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to list branches: %v", err)), nil
 	}
 
 	// Get tags
-	tags, _, err := util.GitlabClient().Tags.ListTags(args.ProjectPath, nil)
+	tags, _, err := util.GitlabClientFromContext(ctx).Tags.ListTags(args.ProjectPath, nil, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to list tags: %v", err)), nil
 	}
 
 	// Build basic project info
-	result := fmt.Sprintf("Project Details:\nID: %d\nName: %s\nPath: %s\nDescription: %s\nURL: %s\nDefault Branch: %s\n\n",
+	result := fmt.Sprintf("Project Details:\nID: %d\nName: %s\nPath: %s\nDescription: %s\nURL: %s\nDefault Branch: %s\n",
 		project.ID, project.Name, project.PathWithNamespace, project.Description, project.WebURL,
 		project.DefaultBranch)
 
+	// Add fork relationship
+	if project.ForkedFromProject != nil {
+		result += fmt.Sprintf("Forked From: %s (ID: %d)\n", project.ForkedFromProject.PathWithNamespace, project.ForkedFromProject.ID)
+	}
+	result += fmt.Sprintf("Forks: %d\n\n", project.ForksCount)
+
+	// Statistics are only returned when requested
+	if project.Statistics != nil {
+		result += "Statistics:\n"
+		result += fmt.Sprintf("Commit Count: %d\n", project.Statistics.CommitCount)
+		result += fmt.Sprintf("Repository Size: %d bytes\n", project.Statistics.RepositorySize)
+		result += fmt.Sprintf("Storage Size: %d bytes\n", project.Statistics.StorageSize)
+		result += fmt.Sprintf("LFS Objects Size: %d bytes\n", project.Statistics.LFSObjectsSize)
+		result += fmt.Sprintf("Job Artifacts Size: %d bytes\n\n", project.Statistics.JobArtifactsSize)
+	}
+
 	// Add branches
 	result += "Branches:\n"
 	for _, branch := range branches {
@@ -100,4 +334,369 @@ func getProjectHandler(ctx context.Context, request mcp.CallToolRequest, args Ge
 	}
 
 	return mcp.NewToolResultText(result), nil
-} 
\ No newline at end of file
+}
+
+func getProjectLanguagesHandler(ctx context.Context, request mcp.CallToolRequest, args GetProjectLanguagesArgs) (*mcp.CallToolResult, error) {
+	languages, _, err := util.GitlabClientFromContext(ctx).Projects.GetProjectLanguages(args.ProjectPath, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get project languages: %v", err)), nil
+	}
+
+	if languages == nil || len(*languages) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No languages detected for %s\n", args.ProjectPath)), nil
+	}
+
+	names := make([]string, 0, len(*languages))
+	for name := range *languages {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return (*languages)[names[i]] > (*languages)[names[j]]
+	})
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Languages in %s:\n", args.ProjectPath))
+	for _, name := range names {
+		result.WriteString(fmt.Sprintf("- %s: %.2f%%\n", name, (*languages)[name]))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func getProjectForksHandler(ctx context.Context, request mcp.CallToolRequest, args GetProjectForksArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.ListProjectsOptions{
+		OrderBy: gitlab.Ptr("last_activity_at"),
+		Sort:    gitlab.Ptr("desc"),
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+
+	if args.Search != "" {
+		opt.Search = gitlab.Ptr(args.Search)
+	}
+
+	forks, _, err := util.GitlabClientFromContext(ctx).Projects.ListProjectForks(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list project forks: %v", err)), nil
+	}
+
+	if len(forks) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No forks found for project %s", args.ProjectPath)), nil
+	}
+
+	result := fmt.Sprintf("Forks of %s (%d):\n\n", args.ProjectPath, len(forks))
+	for _, fork := range forks {
+		result += fmt.Sprintf("ID: %d\nName: %s\nPath: %s\nURL: %s\n", fork.ID, fork.Name, fork.PathWithNamespace, fork.WebURL)
+		if fork.Owner != nil {
+			result += fmt.Sprintf("Owner: %s\n", fork.Owner.Username)
+		}
+		if fork.LastActivityAt != nil {
+			result += fmt.Sprintf("Last Activity: %s\n", fork.LastActivityAt.Format("2006-01-02 15:04:05"))
+		}
+		result += "\n"
+	}
+
+	return mcp.NewToolResultText(result), nil
+}
+
+func projectMergeSettingsHandler(ctx context.Context, request mcp.CallToolRequest, args ProjectMergeSettingsArgs) (*mcp.CallToolResult, error) {
+	switch args.Action {
+	case "get":
+		project, _, err := util.GitlabClientFromContext(ctx).Projects.GetProject(args.ProjectPath, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to get project: %v", err)), nil
+		}
+		return mcp.NewToolResultText(formatProjectMergeSettings(project)), nil
+	case "update":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with updating project merge settings."), nil
+		}
+		if args.OnlyAllowMergeIfPipelineSucceeds == nil && args.OnlyAllowMergeIfAllDiscussionsAreResolved == nil {
+			return mcp.NewToolResultError("at least one of only_allow_merge_if_pipeline_succeeds or only_allow_merge_if_all_discussions_are_resolved is required for update action"), nil
+		}
+
+		opt := &gitlab.EditProjectOptions{
+			OnlyAllowMergeIfPipelineSucceeds:          args.OnlyAllowMergeIfPipelineSucceeds,
+			OnlyAllowMergeIfAllDiscussionsAreResolved: args.OnlyAllowMergeIfAllDiscussionsAreResolved,
+		}
+
+		project, _, err := util.GitlabClientFromContext(ctx).Projects.EditProject(args.ProjectPath, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to update project merge settings: %v", err)), nil
+		}
+		return mcp.NewToolResultText("✅ Merge settings updated\n\n" + formatProjectMergeSettings(project)), nil
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s", args.Action)), nil
+	}
+}
+
+func formatProjectMergeSettings(project *gitlab.Project) string {
+	result := fmt.Sprintf("Merge settings for %s:\n", project.PathWithNamespace)
+	result += fmt.Sprintf("Merge Method: %s\n", project.MergeMethod)
+	result += fmt.Sprintf("Squash Option: %s\n", project.SquashOption)
+	result += fmt.Sprintf("Only Allow Merge If Pipeline Succeeds: %v\n", project.OnlyAllowMergeIfPipelineSucceeds)
+	result += fmt.Sprintf("Allow Merge On Skipped Pipeline: %v\n", project.AllowMergeOnSkippedPipeline)
+	result += fmt.Sprintf("Only Allow Merge If All Discussions Are Resolved: %v\n", project.OnlyAllowMergeIfAllDiscussionsAreResolved)
+	result += fmt.Sprintf("Merge Pipelines Enabled: %v\n", project.MergePipelinesEnabled)
+	result += fmt.Sprintf("Merge Trains Enabled: %v\n", project.MergeTrainsEnabled)
+	return result
+}
+
+func auditProjectAccessHandler(ctx context.Context, request mcp.CallToolRequest, args AuditProjectAccessArgs) (*mcp.CallToolResult, error) {
+	project, _, err := util.GitlabClientFromContext(ctx).Projects.GetProject(args.ProjectPath, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get project: %v", err)), nil
+	}
+
+	// Direct and inherited members, so group-level access shows up too
+	var members []*gitlab.ProjectMember
+	opt := &gitlab.ListProjectMembersOptions{
+		ListOptions: gitlab.ListOptions{PerPage: util.DefaultPerPage(100)},
+	}
+	for {
+		page, resp, err := util.GitlabClientFromContext(ctx).ProjectMembers.ListAllProjectMembers(args.ProjectPath, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to list project members: %v", err)), nil
+		}
+		members = append(members, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Access audit for %s:\n\n", project.PathWithNamespace))
+	result.WriteString(fmt.Sprintf("Visibility: %s\n", project.Visibility))
+	result.WriteString(fmt.Sprintf("Archived: %v\n", project.Archived))
+	result.WriteString(fmt.Sprintf("Request Access Enabled: %v\n", project.RequestAccessEnabled))
+	result.WriteString(fmt.Sprintf("Public Jobs: %v\n", project.PublicJobs))
+	if project.Visibility == gitlab.PublicVisibility {
+		result.WriteString("⚠️ Anyone, including unauthenticated users, can read this repository\n")
+	} else if project.Visibility == gitlab.InternalVisibility {
+		result.WriteString("⚠️ Any signed-in user of this GitLab instance can read this repository\n")
+	}
+
+	result.WriteString(fmt.Sprintf("\nMembers (%d, including inherited):\n", len(members)))
+	if len(members) == 0 {
+		result.WriteString("No members found.\n")
+	}
+	for _, member := range members {
+		result.WriteString(fmt.Sprintf("- %s (%s): %s", member.Username, member.Name, getAccessLevelString(member.AccessLevel)))
+		if member.State != "" && member.State != "active" {
+			result.WriteString(fmt.Sprintf(" [%s]", member.State))
+		}
+		if member.ExpiresAt != nil {
+			result.WriteString(fmt.Sprintf(" expires %s", member.ExpiresAt.String()))
+		}
+		result.WriteString("\n")
+	}
+
+	result.WriteString(fmt.Sprintf("\nShared With Groups (%d):\n", len(project.SharedWithGroups)))
+	if len(project.SharedWithGroups) == 0 {
+		result.WriteString("Not shared with any group.\n")
+	}
+	for _, group := range project.SharedWithGroups {
+		result.WriteString(fmt.Sprintf("- %s: %s\n", group.GroupFullPath, getAccessLevelString(gitlab.AccessLevelValue(group.GroupAccessLevel))))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func createProjectHandler(ctx context.Context, request mcp.CallToolRequest, args CreateProjectArgs) (*mcp.CallToolResult, error) {
+	if !args.Confirmed {
+		return mcp.NewToolResultError(fmt.Sprintf("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating project %s.", args.Name)), nil
+	}
+
+	// Default to private rather than the instance default, which may be public
+	opt := &gitlab.CreateProjectOptions{
+		Name:       gitlab.Ptr(args.Name),
+		Visibility: gitlab.Ptr(gitlab.PrivateVisibility),
+	}
+	if args.Path != "" {
+		opt.Path = gitlab.Ptr(args.Path)
+	}
+	if args.NamespaceID > 0 {
+		opt.NamespaceID = gitlab.Ptr(args.NamespaceID)
+	}
+	if args.Description != "" {
+		opt.Description = gitlab.Ptr(args.Description)
+	}
+	if args.Visibility != "" {
+		opt.Visibility = gitlab.Ptr(gitlab.VisibilityValue(args.Visibility))
+	}
+	if args.DefaultBranch != "" {
+		opt.DefaultBranch = gitlab.Ptr(args.DefaultBranch)
+	}
+	if args.InitializeWithReadme {
+		opt.InitializeWithReadme = gitlab.Ptr(true)
+	}
+
+	project, _, err := util.GitlabClientFromContext(ctx).Projects.CreateProject(opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to create project: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText("✅ Project created\n\n" + formatCreatedProject(project)), nil
+}
+
+func forkProjectHandler(ctx context.Context, request mcp.CallToolRequest, args ForkProjectArgs) (*mcp.CallToolResult, error) {
+	if !args.Confirmed {
+		return mcp.NewToolResultError(fmt.Sprintf("This operation requires confirmation. Please set 'confirmed: true' to proceed with forking %s.", args.ProjectPath)), nil
+	}
+
+	opt := &gitlab.ForkProjectOptions{}
+	if args.Namespace != "" {
+		opt.NamespacePath = gitlab.Ptr(args.Namespace)
+	}
+	if args.Name != "" {
+		opt.Name = gitlab.Ptr(args.Name)
+	}
+	if args.Path != "" {
+		opt.Path = gitlab.Ptr(args.Path)
+	}
+	if args.Visibility != "" {
+		opt.Visibility = gitlab.Ptr(gitlab.VisibilityValue(args.Visibility))
+	}
+
+	fork, _, err := util.GitlabClientFromContext(ctx).Projects.ForkProject(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to fork project: %v", err)), nil
+	}
+
+	// Forking is asynchronous, the repository may still be importing
+	result := fmt.Sprintf("✅ Fork of %s created\n\n", args.ProjectPath) + formatCreatedProject(fork)
+	if fork.ImportStatus != "" && fork.ImportStatus != "finished" {
+		result += fmt.Sprintf("⏳ Repository import status: %s\n", fork.ImportStatus)
+	}
+	return mcp.NewToolResultText(result), nil
+}
+
+func formatCreatedProject(project *gitlab.Project) string {
+	result := fmt.Sprintf("ID: %d\n", project.ID)
+	result += fmt.Sprintf("Name: %s\n", project.Name)
+	result += fmt.Sprintf("Path: %s\n", project.PathWithNamespace)
+	result += fmt.Sprintf("URL: %s\n", project.WebURL)
+	result += fmt.Sprintf("Visibility: %s\n", project.Visibility)
+	if project.DefaultBranch != "" {
+		result += fmt.Sprintf("Default Branch: %s\n", project.DefaultBranch)
+	}
+	return result
+}
+
+func projectMembersHandler(ctx context.Context, request mcp.CallToolRequest, args ProjectMembersArgs) (*mcp.CallToolResult, error) {
+	if args.Action == "list" {
+		return listProjectMembers(ctx, args)
+	}
+
+	if !args.Confirmed {
+		return mcp.NewToolResultError(fmt.Sprintf("This operation requires confirmation. Please set 'confirmed: true' to proceed with the %s member action.", args.Action)), nil
+	}
+
+	if args.UserID <= 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("user_id is required for %s action", args.Action)), nil
+	}
+
+	client := util.GitlabClientFromContext(ctx)
+	switch args.Action {
+	case "add", "update":
+		if args.AccessLevel == "" && (args.Action == "add" || args.ExpiresAt == "") {
+			return mcp.NewToolResultError(fmt.Sprintf("access_level is required for %s action", args.Action)), nil
+		}
+
+		var level *gitlab.AccessLevelValue
+		if args.AccessLevel != "" {
+			value, err := parseAccessLevelName(args.AccessLevel)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid access_level: %v", err)), nil
+			}
+			level = gitlab.Ptr(value)
+		} else {
+			// GitLab requires access_level on every update, so an expiry-only
+			// change resends the member's current level
+			current, _, err := client.ProjectMembers.GetProjectMember(args.ProjectPath, args.UserID, gitlab.WithContext(ctx))
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get current access level of user %d: %v", args.UserID, err)), nil
+			}
+			level = gitlab.Ptr(current.AccessLevel)
+		}
+		var expiresAt *string
+		if args.ExpiresAt != "" {
+			expiresAt = gitlab.Ptr(args.ExpiresAt)
+		}
+
+		var member *gitlab.ProjectMember
+		var err error
+		if args.Action == "add" {
+			member, _, err = client.ProjectMembers.AddProjectMember(args.ProjectPath, &gitlab.AddProjectMemberOptions{
+				UserID:      args.UserID,
+				AccessLevel: level,
+				ExpiresAt:   expiresAt,
+			}, gitlab.WithContext(ctx))
+		} else {
+			member, _, err = client.ProjectMembers.EditProjectMember(args.ProjectPath, args.UserID, &gitlab.EditProjectMemberOptions{
+				AccessLevel: level,
+				ExpiresAt:   expiresAt,
+			}, gitlab.WithContext(ctx))
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to %s project member: %v", args.Action, err)), nil
+		}
+
+		verb := "added to"
+		if args.Action == "update" {
+			verb = "updated in"
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("✅ Member %s %s %s\n\n", member.Username, verb, args.ProjectPath) + formatProjectMember(member)), nil
+	case "remove":
+		_, err := client.ProjectMembers.DeleteProjectMember(args.ProjectPath, args.UserID, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to remove project member: %v", err)), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("✅ User %d removed from %s", args.UserID, args.ProjectPath)), nil
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s", args.Action)), nil
+	}
+}
+
+// listProjectMembers lists direct members only, audit_project_access covers inherited ones
+func listProjectMembers(ctx context.Context, args ProjectMembersArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.ListProjectMembersOptions{
+		ListOptions: gitlab.ListOptions{PerPage: util.DefaultPerPage(100)},
+	}
+	if args.Search != "" {
+		opt.Query = gitlab.Ptr(args.Search)
+	}
+
+	members, err := util.AllPages(&opt.ListOptions, func() ([]*gitlab.ProjectMember, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).ProjectMembers.ListProjectMembers(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list project members: %v", err)), nil
+	}
+
+	if len(members) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No direct members found for project %s", args.ProjectPath)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Members of %s (%d):\n\n", args.ProjectPath, len(members)))
+	for _, member := range members {
+		result.WriteString(formatProjectMember(member))
+		result.WriteString("\n")
+	}
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func formatProjectMember(member *gitlab.ProjectMember) string {
+	result := fmt.Sprintf("User: %s\n", member.Username)
+	result += fmt.Sprintf("Name: %s\n", member.Name)
+	result += fmt.Sprintf("ID: %d\n", member.ID)
+	result += fmt.Sprintf("State: %s\n", member.State)
+	result += fmt.Sprintf("Access Level: %s\n", getAccessLevelString(member.AccessLevel))
+	if member.ExpiresAt != nil {
+		result += fmt.Sprintf("Expires At: %s\n", member.ExpiresAt.String())
+	}
+	return result
+}
@@ -0,0 +1,135 @@
+package tools
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestListGroupProjectsStopsAtActivityCutoff(t *testing.T) {
+	now := time.Now().UTC()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/groups/mygroup/projects", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("min_access_level"); got != "10" {
+			t.Errorf("membership scope sent min_access_level=%q, want 10", got)
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{
+			{"id": 1, "name": "active", "last_activity_at": now.Add(-24 * time.Hour).Format(time.RFC3339)},
+			{"id": 2, "name": "stale", "last_activity_at": now.AddDate(0, -2, 0).Format(time.RFC3339)},
+		})
+	})
+
+	result, err := listProjectsHandler(newTestContext(t, mux), mcp.CallToolRequest{}, ListProjectsArgs{
+		GroupID:           "mygroup",
+		Scope:             "membership",
+		LastActivityAfter: "7d",
+	})
+	text := resultText(t, result, err)
+
+	if !strings.Contains(text, "Name: active") || strings.Contains(text, "Name: stale") {
+		t.Errorf("activity filter not applied:\n%s", text)
+	}
+	if strings.Contains(text, "More projects available") {
+		t.Errorf("suggests another page past the activity cutoff:\n%s", text)
+	}
+}
+
+func TestUpdateProjectMemberExpiryKeepsAccessLevel(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/group%2Fproject/members/7", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"id": 7, "username": "alice", "access_level": 30})
+	})
+	mux.HandleFunc("PUT /api/v4/projects/group%2Fproject/members/7", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			AccessLevel int    `json:"access_level"`
+			ExpiresAt   string `json:"expires_at"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+			return
+		}
+		if body.AccessLevel != 30 {
+			t.Errorf("access_level sent as %d, want the current level 30", body.AccessLevel)
+		}
+		writeJSON(t, w, map[string]any{"id": 7, "username": "alice", "access_level": body.AccessLevel, "expires_at": body.ExpiresAt})
+	})
+
+	result, err := projectMembersHandler(newTestContext(t, mux), mcp.CallToolRequest{}, ProjectMembersArgs{
+		Action:      "update",
+		ProjectPath: "group/project",
+		UserID:      7,
+		ExpiresAt:   "2030-01-01",
+		Confirmed:   true,
+	})
+	text := resultText(t, result, err)
+
+	if !strings.Contains(text, "Access Level: Developer") {
+		t.Errorf("result should keep the developer level:\n%s", text)
+	}
+}
+
+func TestProjectMembersRequiresUserID(t *testing.T) {
+	result, err := projectMembersHandler(newTestContext(t, http.NewServeMux()), mcp.CallToolRequest{}, ProjectMembersArgs{
+		Action:      "remove",
+		ProjectPath: "group/project",
+		Confirmed:   true,
+	})
+	if err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	if !result.IsError {
+		t.Fatalf("expected an error result without user_id, got:\n%s", toolResultText(result))
+	}
+}
+
+func TestListProjectMembersReadsEveryPage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/group%2Fproject/members", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, []map[string]any{{"id": 8, "username": "bob", "access_level": 30}})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{{"id": 7, "username": "alice", "access_level": 40}})
+	})
+
+	result, err := projectMembersHandler(newTestContext(t, mux), mcp.CallToolRequest{}, ProjectMembersArgs{
+		Action:      "list",
+		ProjectPath: "group/project",
+	})
+	text := resultText(t, result, err)
+
+	for _, want := range []string{"(2):", "User: alice", "User: bob"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("result missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestCreateProjectDefaultsToPrivate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Visibility string `json:"visibility"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+			return
+		}
+		if body.Visibility != "private" {
+			t.Errorf("visibility sent as %q, want private", body.Visibility)
+		}
+		writeJSON(t, w, map[string]any{"id": 1, "name": "demo", "path_with_namespace": "me/demo", "visibility": body.Visibility})
+	})
+
+	result, err := createProjectHandler(newTestContext(t, mux), mcp.CallToolRequest{}, CreateProjectArgs{
+		Name:      "demo",
+		Confirmed: true,
+	})
+	resultText(t, result, err)
+}
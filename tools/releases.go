@@ -0,0 +1,240 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/gitlab-mcp/util"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Consolidated release management arguments with action-based routing
+type ReleaseManagementArgs struct {
+	Action      string `json:"action" validate:"required,oneof=list get create update delete"`
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=255"`
+	TagName     string `json:"tag_name,omitempty" validate:"omitempty,min=1,max=255"`
+	Confirmed   bool   `json:"confirmed,omitempty"`
+
+	// Create/update fields
+	Name        string             `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	Description string             `json:"description,omitempty"`
+	Ref         string             `json:"ref,omitempty" validate:"omitempty,min=1,max=255"`
+	AssetLinks  []ReleaseAssetLink `json:"asset_links,omitempty" validate:"omitempty,dive"`
+}
+
+type ReleaseAssetLink struct {
+	Name     string `json:"name" validate:"required,min=1"`
+	URL      string `json:"url" validate:"required,url"`
+	LinkType string `json:"link_type,omitempty" validate:"omitempty,oneof=other runbook image package"`
+}
+
+func RegisterReleaseTools(s *server.MCPServer) {
+	releaseManagementTool := mcp.NewTool("manage_releases",
+		mcp.WithDescription("Manage project releases: list, get, create, update, delete"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: list, get, create, update, delete")),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
+		mcp.WithString("tag_name", mcp.Description("Tag the release belongs to (required for: get, create, update, delete)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for create, update and delete actions")),
+		mcp.WithString("name", mcp.Description("Release title (create, update)")),
+		mcp.WithString("description", mcp.Description("Release notes in Markdown (create, update)")),
+		mcp.WithString("ref", mcp.Description("Branch or commit SHA to create the tag from when tag_name doesn't exist yet (create)")),
+		mcp.WithArray("asset_links",
+			mcp.Description("Asset links to attach to the release (create)"),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Link name",
+					},
+					"url": map[string]any{
+						"type":        "string",
+						"description": "Link URL",
+					},
+					"link_type": map[string]any{
+						"type":        "string",
+						"description": "Link type",
+						"enum":        []string{"other", "runbook", "image", "package"},
+					},
+				},
+				"required": []string{"name", "url"},
+			}),
+		),
+	)
+
+	s.AddTool(releaseManagementTool, mcp.NewTypedToolHandler(releaseManagementHandler))
+}
+
+// Consolidated release management handler
+func releaseManagementHandler(ctx context.Context, request mcp.CallToolRequest, args ReleaseManagementArgs) (*mcp.CallToolResult, error) {
+	if args.Action != "list" && args.TagName == "" {
+		return mcp.NewToolResultError(fmt.Sprintf("tag_name is required for %s action", args.Action)), nil
+	}
+
+	switch args.Action {
+	case "list":
+		return handleListReleases(ctx, args)
+	case "get":
+		return handleGetRelease(ctx, args)
+	case "create":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating the release."), nil
+		}
+		return handleCreateRelease(ctx, args)
+	case "update":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with updating the release."), nil
+		}
+		if args.Name == "" && args.Description == "" {
+			return mcp.NewToolResultError("at least one of name or description is required for update action"), nil
+		}
+		return handleUpdateRelease(ctx, args)
+	case "delete":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with deleting the release."), nil
+		}
+		return handleDeleteRelease(ctx, args)
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list, get, create, update, delete", args.Action)), nil
+	}
+}
+
+// Upper bound on releases gathered across pages for one list
+const maxListedReleases = 500
+
+func handleListReleases(ctx context.Context, args ReleaseManagementArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.ListReleasesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+	releases, truncated, err := util.CollectPages(&opt.ListOptions, maxListedReleases, func() ([]*gitlab.Release, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).Releases.ListReleases(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list releases: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Releases for project %s:\n\n", args.ProjectPath))
+
+	if len(releases) == 0 {
+		result.WriteString("No releases found.\n")
+		return mcp.NewToolResultText(result.String()), nil
+	}
+
+	for _, release := range releases {
+		result.WriteString(fmt.Sprintf("- %s (tag: %s)", release.Name, release.TagName))
+		if release.ReleasedAt != nil {
+			result.WriteString(fmt.Sprintf(" released %s", release.ReleasedAt.Format("2006-01-02 15:04:05")))
+		}
+		result.WriteString("\n")
+	}
+	if truncated {
+		result.WriteString(fmt.Sprintf("\nShowing the %d most recent releases, older ones exist.\n", maxListedReleases))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func handleGetRelease(ctx context.Context, args ReleaseManagementArgs) (*mcp.CallToolResult, error) {
+	release, _, err := util.GitlabClientFromContext(ctx).Releases.GetRelease(args.ProjectPath, args.TagName, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get release: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(formatReleaseInfo(release)), nil
+}
+
+func handleCreateRelease(ctx context.Context, args ReleaseManagementArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.CreateReleaseOptions{
+		TagName: gitlab.Ptr(args.TagName),
+	}
+	if args.Name != "" {
+		opt.Name = gitlab.Ptr(args.Name)
+	}
+	if args.Description != "" {
+		opt.Description = gitlab.Ptr(args.Description)
+	}
+	if args.Ref != "" {
+		opt.Ref = gitlab.Ptr(args.Ref)
+	}
+	if len(args.AssetLinks) > 0 {
+		links := make([]*gitlab.ReleaseAssetLinkOptions, len(args.AssetLinks))
+		for i, link := range args.AssetLinks {
+			links[i] = &gitlab.ReleaseAssetLinkOptions{
+				Name: gitlab.Ptr(link.Name),
+				URL:  gitlab.Ptr(link.URL),
+			}
+			if link.LinkType != "" {
+				links[i].LinkType = gitlab.Ptr(gitlab.LinkTypeValue(link.LinkType))
+			}
+		}
+		opt.Assets = &gitlab.ReleaseAssetsOptions{Links: links}
+	}
+
+	release, _, err := util.GitlabClientFromContext(ctx).Releases.CreateRelease(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to create release: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText("✅ Release created\n\n" + formatReleaseInfo(release)), nil
+}
+
+func handleUpdateRelease(ctx context.Context, args ReleaseManagementArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.UpdateReleaseOptions{}
+	if args.Name != "" {
+		opt.Name = gitlab.Ptr(args.Name)
+	}
+	if args.Description != "" {
+		opt.Description = gitlab.Ptr(args.Description)
+	}
+
+	release, _, err := util.GitlabClientFromContext(ctx).Releases.UpdateRelease(args.ProjectPath, args.TagName, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to update release: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText("✅ Release updated\n\n" + formatReleaseInfo(release)), nil
+}
+
+func handleDeleteRelease(ctx context.Context, args ReleaseManagementArgs) (*mcp.CallToolResult, error) {
+	_, _, err := util.GitlabClientFromContext(ctx).Releases.DeleteRelease(args.ProjectPath, args.TagName, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to delete release: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Successfully deleted release for tag '%s' in project %s (the tag itself is kept)\n", args.TagName, args.ProjectPath)), nil
+}
+
+// Helper function to format release information
+func formatReleaseInfo(release *gitlab.Release) string {
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Release: %s\n", release.Name))
+	result.WriteString(fmt.Sprintf("Tag: %s\n", release.TagName))
+	result.WriteString(fmt.Sprintf("Commit: %s\n", release.Commit.ID))
+	if release.Author.Username != "" {
+		result.WriteString(fmt.Sprintf("Author: %s\n", release.Author.Username))
+	}
+	if release.CreatedAt != nil {
+		result.WriteString(fmt.Sprintf("Created: %s\n", release.CreatedAt.Format("2006-01-02 15:04:05")))
+	}
+	if release.ReleasedAt != nil {
+		result.WriteString(fmt.Sprintf("Released: %s\n", release.ReleasedAt.Format("2006-01-02 15:04:05")))
+	}
+
+	if len(release.Assets.Links) > 0 {
+		result.WriteString("\nAsset Links:\n")
+		for _, link := range release.Assets.Links {
+			result.WriteString(fmt.Sprintf("- %s: %s\n", link.Name, link.URL))
+		}
+	}
+
+	if release.Description != "" {
+		result.WriteString(fmt.Sprintf("\nDescription:\n%s\n", release.Description))
+	}
+	return result.String()
+}
@@ -0,0 +1,31 @@
+package tools
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestListReleasesReadsEveryPage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/releases", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, []map[string]any{{"name": "v1.0", "tag_name": "v1.0"}})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{{"name": "v2.0", "tag_name": "v2.0"}})
+	})
+
+	result, err := handleListReleases(newTestContext(t, mux), ReleaseManagementArgs{Action: "list", ProjectPath: "group/project"})
+	text := resultText(t, result, err)
+
+	for _, want := range []string{"- v2.0 (tag: v2.0)", "- v1.0 (tag: v1.0)"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("result missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "Showing the") {
+		t.Errorf("result should not be marked truncated:\n%s", text)
+	}
+}
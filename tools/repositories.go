@@ -2,7 +2,11 @@ package tools
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -14,33 +18,47 @@ import (
 
 // Consolidated Repository Files Management
 type RepositoryFilesArgs struct {
-	Action      string `json:"action" validate:"required,oneof=get_content"`
+	Action      string `json:"action" validate:"required,oneof=get_content blame create update delete"`
 	ProjectPath string `json:"project_path" validate:"required,min=1,max=255"`
 	FilePath    string `json:"file_path" validate:"required,min=1,max=500"`
-	Ref         string `json:"ref" validate:"required,min=1,max=255"`
+	Ref         string `json:"ref,omitempty" validate:"omitempty,min=1,max=255"`
+	LineStart   int    `json:"line_start,omitempty" validate:"omitempty,min=1"`
+	LineEnd     int    `json:"line_end,omitempty" validate:"omitempty,min=1"`
+	Confirmed   bool   `json:"confirmed,omitempty"`
+
+	// Write action (create/update/delete) parameters
+	Branch        string `json:"branch,omitempty" validate:"omitempty,min=1,max=255"`
+	Content       string `json:"content,omitempty"`
+	CommitMessage string `json:"commit_message,omitempty" validate:"omitempty,min=1"`
+	AuthorEmail   string `json:"author_email,omitempty" validate:"omitempty,email"`
+	AuthorName    string `json:"author_name,omitempty" validate:"omitempty,min=1,max=255"`
 }
 
 // Consolidated Commits Management
 type CommitsManagementArgs struct {
-	Action      string `json:"action" validate:"required,oneof=list search get_details get_comments post_comment get_merge_requests get_refs"`
+	Action      string `json:"action" validate:"required,oneof=list search get_details get_comments post_comment get_merge_requests get_refs last_modified"`
 	ProjectPath string `json:"project_path" validate:"required,min=1,max=255"`
 	Confirmed   bool   `json:"confirmed,omitempty"`
 	
 	// Common commit parameters
 	CommitSHA string `json:"commit_sha,omitempty" validate:"omitempty,min=7,max=40,alphanum"`
 	Ref       string `json:"ref,omitempty" validate:"omitempty,min=1,max=255"`
+	Timezone  string `json:"timezone,omitempty" validate:"omitempty,min=1,max=100"`
 	
 	// List/Search specific parameters
 	ListOptions struct {
-		Since string `json:"since,omitempty" validate:"omitempty,datetime=2006-01-02"`
-		Until string `json:"until,omitempty" validate:"omitempty,datetime=2006-01-02"`
+		Since                string `json:"since,omitempty" validate:"omitempty,min=1,max=50"`
+		Until                string `json:"until,omitempty" validate:"omitempty,min=1,max=50"`
+		IncludeMergeRequests bool   `json:"include_merge_requests,omitempty"`
+		MaxResults           int    `json:"max_results,omitempty" validate:"omitempty,min=1,max=5000"`
 	} `json:"list_options"`
 	
 	SearchOptions struct {
-		Author string `json:"author,omitempty" validate:"omitempty,min=1,max=100"`
-		Path   string `json:"path,omitempty" validate:"omitempty,min=1,max=500"`
-		Since  string `json:"since,omitempty" validate:"omitempty,datetime=2006-01-02"`
-		Until  string `json:"until,omitempty" validate:"omitempty,datetime=2006-01-02"`
+		Author     string `json:"author,omitempty" validate:"omitempty,min=1,max=100"`
+		Path       string `json:"path,omitempty" validate:"omitempty,min=1,max=500"`
+		Since      string `json:"since,omitempty" validate:"omitempty,min=1,max=50"`
+		Until      string `json:"until,omitempty" validate:"omitempty,min=1,max=50"`
+		MaxResults int    `json:"max_results,omitempty" validate:"omitempty,min=1,max=5000"`
 	} `json:"search_options"`
 	
 	// Comment specific parameters
@@ -55,6 +73,16 @@ type CommitsManagementArgs struct {
 	RefsOptions struct {
 		Type string `json:"type,omitempty" validate:"omitempty,oneof=branch tag"`
 	} `json:"refs_options"`
+
+	// Last modified specific parameters
+	LastModifiedOptions struct {
+		FilePaths []string `json:"file_paths,omitempty" validate:"omitempty,max=50,dive,min=1,max=500"`
+	} `json:"last_modified_options"`
+
+	// Details specific parameters
+	DetailsOptions struct {
+		Raw bool `json:"raw,omitempty"`
+	} `json:"details_options"`
 }
 
 // Consolidated Commit Operations
@@ -67,43 +95,123 @@ type CommitOperationsArgs struct {
 	
 	// Cherry-pick specific options
 	CherryPickOptions struct {
-		DryRun  bool   `json:"dry_run"`
-		Message string `json:"message,omitempty" validate:"omitempty,min=1,max=500"`
+		DryRun             bool   `json:"dry_run"`
+		Message            string `json:"message,omitempty" validate:"omitempty,min=1,max=500"`
+		CreateMergeRequest bool   `json:"create_merge_request,omitempty"`
+		NewBranch          string `json:"new_branch,omitempty" validate:"omitempty,min=1,max=255"`
 	} `json:"cherry_pick_options"`
+
+	// Revert specific options
+	RevertOptions struct {
+		CreateMergeRequest bool   `json:"create_merge_request,omitempty"`
+		NewBranch          string `json:"new_branch,omitempty" validate:"omitempty,min=1,max=255"`
+	} `json:"revert_options"`
+}
+
+// Ref status summary
+type RefStatusArgs struct {
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=255"`
+	Ref         string `json:"ref" validate:"required,min=1,max=255"`
+	CompareTo   string `json:"compare_to,omitempty" validate:"omitempty,min=1,max=255"`
 }
 
+// Ref comparison
+type CompareRefsArgs struct {
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=255"`
+	From        string `json:"from" validate:"required,min=1,max=255"`
+	To          string `json:"to" validate:"required,min=1,max=255"`
+	Straight    bool   `json:"straight,omitempty"`
+}
+
+// Commit status reporting
+type CommitStatusesArgs struct {
+	Action      string `json:"action" validate:"required,oneof=list set"`
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=255"`
+	CommitSHA   string `json:"commit_sha" validate:"required,min=7,max=40,alphanum"`
+	State       string `json:"state,omitempty" validate:"required_if=Action set,omitempty,oneof=pending running success failed canceled"`
+	Name        string `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	Ref         string `json:"ref,omitempty" validate:"omitempty,min=1,max=255"`
+	TargetURL   string `json:"target_url,omitempty" validate:"omitempty,url"`
+	Description string `json:"description,omitempty" validate:"omitempty,max=255"`
+	Confirmed   bool   `json:"confirmed,omitempty"`
+}
+
+// Project README lookup
+type ProjectReadmeArgs struct {
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=255"`
+	Ref         string `json:"ref,omitempty" validate:"omitempty,min=1,max=255"`
+}
+
+// Repository archive download
+type DownloadArchiveArgs struct {
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=255"`
+	Ref         string `json:"ref,omitempty" validate:"omitempty,min=1,max=255"`
+	Format      string `json:"format,omitempty" validate:"omitempty,oneof=tar.gz zip"`
+	Path        string `json:"path,omitempty" validate:"omitempty,min=1,max=500"`
+	MaxBytes    int    `json:"max_bytes,omitempty" validate:"omitempty,min=1"`
+}
+
+// Default cap on archive bytes returned; base64 adds another third on top
+const defaultArchiveMaxBytes = 1024 * 1024
+
 func RegisterRepositoryTools(s *server.MCPServer) {
 	// Consolidated Repository Files Tool
 	repositoryFilesTool := mcp.NewTool("manage_repository_files",
-		mcp.WithDescription("Manage repository files with various actions: get_content"),
-		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: get_content")),
+		mcp.WithDescription("Manage repository files with various actions: get_content, blame, create, update, delete"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: get_content, blame, create, update, delete")),
 		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
 		mcp.WithString("file_path", mcp.Required(), mcp.Description("Path to the file in the repository (1-500 characters)")),
-		mcp.WithString("ref", mcp.Required(), mcp.Description("Branch name, tag, or commit SHA (1-255 characters)")),
+		mcp.WithString("ref", mcp.Description("Branch name, tag, or commit SHA for get_content and blame (1-255 characters, defaults to the project's default branch)")),
+		mcp.WithNumber("line_start", mcp.Description("First line to return (1-based, optional)")),
+		mcp.WithNumber("line_end", mcp.Description("Last line to return (inclusive, optional - defaults to end of file)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for create, update and delete actions")),
+		mcp.WithString("branch", mcp.Description("Branch to commit to (required for create, update, delete)")),
+		mcp.WithString("content", mcp.Description("New file content (required for create and update)")),
+		mcp.WithString("commit_message", mcp.Description("Commit message (required for create, update, delete)")),
+		mcp.WithString("author_email", mcp.Description("Commit author email (optional)")),
+		mcp.WithString("author_name", mcp.Description("Commit author name (optional)")),
 	)
 
 	// Consolidated Commits Management Tool
 	commitsManagementTool := mcp.NewTool("manage_commits",
-		mcp.WithDescription("Comprehensive commits management with multiple actions: list, search, get_details, get_comments, post_comment, get_merge_requests, get_refs"),
-		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: list, search, get_details, get_comments, post_comment, get_merge_requests, get_refs")),
+		mcp.WithDescription("Comprehensive commits management with multiple actions: list, search, get_details, get_comments, post_comment, get_merge_requests, get_refs, last_modified"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: list, search, get_details, get_comments, post_comment, get_merge_requests, get_refs, last_modified")),
 		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
 		mcp.WithString("commit_sha", mcp.Description("Commit SHA (7-40 alphanumeric characters, required for: get_details, get_comments, post_comment, get_merge_requests, get_refs)")),
-		mcp.WithString("ref", mcp.Description("Branch name, tag, or commit SHA (1-255 characters, required for list action)")),
+		mcp.WithString("ref", mcp.Description("Branch name, tag, or commit SHA for list and search (1-255 characters, defaults to the project's default branch)")),
 		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for post_comment action")),
+		mcp.WithString("timezone", mcp.Description("IANA timezone used for since/until day boundaries in list and search actions, e.g. 'Europe/Berlin' (default: UTC)")),
 		
+		// Details options
+		mcp.WithObject("details_options",
+			mcp.Description("Options for get_details action"),
+			mcp.Properties(map[string]any{
+				"raw": map[string]any{
+					"type":        "boolean",
+					"description": "Return only the unified diff without markdown, ready for git apply (default: false). Fails if a file has no diff from the API, such as a binary file",
+				},
+			}),
+		),
+
 		// List options
 		mcp.WithObject("list_options",
 			mcp.Description("Options for list action"),
 			mcp.Properties(map[string]any{
 				"since": map[string]any{
 					"type":        "string",
-					"description": "Start date (YYYY-MM-DD, required for list action)",
-					"pattern":     "^\\d{4}-\\d{2}-\\d{2}$",
+					"description": "Start date (YYYY-MM-DD or relative like 7d, 2w, yesterday; required for list action)",
 				},
 				"until": map[string]any{
 					"type":        "string",
-					"description": "End date (YYYY-MM-DD, optional - defaults to current date)",
-					"pattern":     "^\\d{4}-\\d{2}-\\d{2}$",
+					"description": "End date (YYYY-MM-DD or relative like 1d, yesterday; optional - defaults to current date)",
+				},
+				"include_merge_requests": map[string]any{
+					"type":        "boolean",
+					"description": "Annotate each commit with the merge request(s) that introduced it (one extra API call per commit, so at most 50 commits are listed)",
+				},
+				"max_results": map[string]any{
+					"type":        "number",
+					"description": "Stop after this many commits (1-5000, default: 500)",
 				},
 			}),
 		),
@@ -126,13 +234,15 @@ func RegisterRepositoryTools(s *server.MCPServer) {
 				},
 				"since": map[string]any{
 					"type":        "string",
-					"description": "Start date (YYYY-MM-DD)",
-					"pattern":     "^\\d{4}-\\d{2}-\\d{2}$",
+					"description": "Start date (YYYY-MM-DD or relative like 7d, 2w, yesterday)",
 				},
 				"until": map[string]any{
 					"type":        "string",
-					"description": "End date (YYYY-MM-DD)",
-					"pattern":     "^\\d{4}-\\d{2}-\\d{2}$",
+					"description": "End date (YYYY-MM-DD or relative like 1d, yesterday)",
+				},
+				"max_results": map[string]any{
+					"type":        "number",
+					"description": "Stop after this many commits (1-5000, default: 500)",
 				},
 			}),
 		),
@@ -177,6 +287,20 @@ func RegisterRepositoryTools(s *server.MCPServer) {
 				},
 			}),
 		),
+
+		// Last modified options
+		mcp.WithObject("last_modified_options",
+			mcp.Description("Options for last_modified action"),
+			mcp.Properties(map[string]any{
+				"file_paths": map[string]any{
+					"type":        "array",
+					"description": "File paths to report the last commit for (1-50 paths, required for last_modified)",
+					"items":       map[string]any{"type": "string"},
+					"minItems":    1,
+					"maxItems":    50,
+				},
+			}),
+		),
 	)
 
 	// Consolidated Commit Operations Tool
@@ -203,46 +327,186 @@ func RegisterRepositoryTools(s *server.MCPServer) {
 					"minLength":   1,
 					"maxLength":   500,
 				},
+				"create_merge_request": map[string]any{
+					"type":        "boolean",
+					"description": "Cherry-pick onto a new branch created from the target branch and open a merge request into it, for protected branches (default: false)",
+				},
+				"new_branch": map[string]any{
+					"type":        "string",
+					"description": "Name of the branch to create with create_merge_request (default: cherry-pick-<short sha>-into-<branch>)",
+				},
+			}),
+		),
+
+		// Revert options
+		mcp.WithObject("revert_options",
+			mcp.Description("Options for revert action"),
+			mcp.Properties(map[string]any{
+				"create_merge_request": map[string]any{
+					"type":        "boolean",
+					"description": "Revert on a new branch created from the target branch and open a merge request into it, for protected branches (default: false)",
+				},
+				"new_branch": map[string]any{
+					"type":        "string",
+					"description": "Name of the branch to create with create_merge_request (default: revert-<short sha>)",
+				},
 			}),
 		),
 	)
 
+	// Ref Status Tool
+	refStatusTool := mcp.NewTool("ref_status",
+		mcp.WithDescription("Summarize a branch or tag: latest commit, its pipeline status, and how far it is ahead/behind the default branch"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
+		mcp.WithString("ref", mcp.Required(), mcp.Description("Branch or tag name (1-255 characters)")),
+		mcp.WithString("compare_to", mcp.Description("Branch to compare against (defaults to the project's default branch)")),
+	)
+
+	// Compare Refs Tool
+	compareRefsTool := mcp.NewTool("compare_refs",
+		mcp.WithDescription("Compare two branches, tags or commits: list the commits between them and summarize the changed files"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
+		mcp.WithString("from", mcp.Required(), mcp.Description("Base branch, tag, or commit SHA (1-255 characters)")),
+		mcp.WithString("to", mcp.Required(), mcp.Description("Head branch, tag, or commit SHA (1-255 characters)")),
+		mcp.WithBoolean("straight", mcp.Description("Compare from and to directly (from..to) instead of from their merge base (from...to, default)")),
+	)
+
+	// Commit Statuses Tool
+	commitStatusesTool := mcp.NewTool("manage_commit_statuses",
+		mcp.WithDescription("Read or report external build statuses on a commit: list, set"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: list, set")),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
+		mcp.WithString("commit_sha", mcp.Required(), mcp.Description("Commit SHA (7-40 alphanumeric characters)")),
+		mcp.WithString("state", mcp.Description("Status to report: pending, running, success, failed, canceled (required for set)")),
+		mcp.WithString("name", mcp.Description("Status name, e.g. 'ci/jenkins' (set defaults to 'default'; filters list)")),
+		mcp.WithString("ref", mcp.Description("Branch or tag the status applies to (optional)")),
+		mcp.WithString("target_url", mcp.Description("URL of the external build, linked from GitLab (set action)")),
+		mcp.WithString("description", mcp.Description("Short description of the status (set action, max 255 characters)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for set action")),
+	)
+
+	// Project README Tool
+	projectReadmeTool := mcp.NewTool("get_project_readme",
+		mcp.WithDescription("Find and return the README at the root of a repository, whatever its exact filename or case"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
+		mcp.WithString("ref", mcp.Description("Branch, tag, or commit SHA (defaults to the project's default branch)")),
+	)
+
+	// Download Archive Tool
+	downloadArchiveTool := mcp.NewTool("download_archive",
+		mcp.WithDescription("Download a snapshot of the repository at a ref as a tar.gz or zip archive, returned base64 encoded"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
+		mcp.WithString("ref", mcp.Description("Branch, tag, or commit SHA (defaults to the project's default branch)")),
+		mcp.WithString("format", mcp.Description("Archive format: tar.gz, zip (default: tar.gz)"), mcp.Enum("tar.gz", "zip")),
+		mcp.WithString("path", mcp.Description("Only archive this subdirectory of the repository")),
+		mcp.WithNumber("max_bytes", mcp.Description("Maximum archive bytes to return before base64 encoding (default: 1048576)")),
+	)
+
 	// Register consolidated tools
 	s.AddTool(repositoryFilesTool, mcp.NewTypedToolHandler(repositoryFilesHandler))
 	s.AddTool(commitsManagementTool, mcp.NewTypedToolHandler(commitsManagementHandler))
 	s.AddTool(commitOperationsTool, mcp.NewTypedToolHandler(commitOperationsHandler))
+	s.AddTool(refStatusTool, mcp.NewTypedToolHandler(refStatusHandler))
+	s.AddTool(compareRefsTool, mcp.NewTypedToolHandler(compareRefsHandler))
+	s.AddTool(commitStatusesTool, mcp.NewTypedToolHandler(commitStatusesHandler))
+	s.AddTool(projectReadmeTool, mcp.NewTypedToolHandler(projectReadmeHandler))
+	s.AddTool(downloadArchiveTool, mcp.NewTypedToolHandler(downloadArchiveHandler))
 }
 
 // Consolidated handlers
 func repositoryFilesHandler(ctx context.Context, request mcp.CallToolRequest, args RepositoryFilesArgs) (*mcp.CallToolResult, error) {
 	switch args.Action {
 	case "get_content":
-		return getFileContent(ctx, args.ProjectPath, args.FilePath, args.Ref)
+		if args.LineStart > 0 && args.LineEnd > 0 && args.LineEnd < args.LineStart {
+			return mcp.NewToolResultError("line_end must be greater than or equal to line_start"), nil
+		}
+		return getFileContent(ctx, args.ProjectPath, args.FilePath, args.Ref, args.LineStart, args.LineEnd)
+	case "blame":
+		if args.LineStart > 0 && args.LineEnd > 0 && args.LineEnd < args.LineStart {
+			return mcp.NewToolResultError("line_end must be greater than or equal to line_start"), nil
+		}
+		return getFileBlame(ctx, args.ProjectPath, args.FilePath, args.Ref, args.LineStart, args.LineEnd)
+	case "create", "update", "delete":
+		if !args.Confirmed {
+			return mcp.NewToolResultError(fmt.Sprintf("This operation requires confirmation. Please set 'confirmed: true' to proceed with the %s file commit.", args.Action)), nil
+		}
+		if args.Branch == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("branch is required for %s action", args.Action)), nil
+		}
+		if args.CommitMessage == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("commit_message is required for %s action", args.Action)), nil
+		}
+		if args.Action != "delete" && args.Content == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("content is required for %s action", args.Action)), nil
+		}
+		return writeRepositoryFile(ctx, args)
 	default:
-		return mcp.NewToolResultError(fmt.Sprintf("invalid action: %s. Valid actions are: get_content", args.Action)), nil
+		return mcp.NewToolResultError(fmt.Sprintf("invalid action: %s. Valid actions are: get_content, blame, create, update, delete", args.Action)), nil
 	}
 }
 
+// writeRepositoryFile commits a file creation, update or deletion to a branch.
+// It goes through the commits API rather than the files API, whose responses
+// don't say which commit the write made.
+func writeRepositoryFile(ctx context.Context, args RepositoryFilesArgs) (*mcp.CallToolResult, error) {
+	action := &gitlab.CommitActionOptions{
+		Action:   gitlab.Ptr(gitlab.FileActionValue(args.Action)),
+		FilePath: gitlab.Ptr(args.FilePath),
+	}
+	if args.Action != "delete" {
+		action.Content = gitlab.Ptr(args.Content)
+	}
+
+	opt := &gitlab.CreateCommitOptions{
+		Branch:        gitlab.Ptr(args.Branch),
+		CommitMessage: gitlab.Ptr(args.CommitMessage),
+		Actions:       []*gitlab.CommitActionOptions{action},
+	}
+	if args.AuthorName != "" {
+		opt.AuthorName = gitlab.Ptr(args.AuthorName)
+	}
+	if args.AuthorEmail != "" {
+		opt.AuthorEmail = gitlab.Ptr(args.AuthorEmail)
+	}
+
+	commit, _, err := util.GitlabClientFromContext(ctx).Commits.CreateCommit(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to %s file: %v", args.Action, err)), nil
+	}
+
+	var result strings.Builder
+	switch args.Action {
+	case "create":
+		result.WriteString(fmt.Sprintf("✅ Created %s on branch %s\n", args.FilePath, args.Branch))
+	case "update":
+		result.WriteString(fmt.Sprintf("✅ Updated %s on branch %s\n", args.FilePath, args.Branch))
+	case "delete":
+		result.WriteString(fmt.Sprintf("✅ Deleted %s from branch %s\n", args.FilePath, args.Branch))
+	}
+	result.WriteString(fmt.Sprintf("Commit: %s\n", commit.ID))
+	result.WriteString(fmt.Sprintf("Message: %s\n", commit.Title))
+	result.WriteString(fmt.Sprintf("URL: %s\n", commit.WebURL))
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
 func commitsManagementHandler(ctx context.Context, request mcp.CallToolRequest, args CommitsManagementArgs) (*mcp.CallToolResult, error) {
 	switch args.Action {
 	case "list":
 		if args.ListOptions.Since == "" {
 			return mcp.NewToolResultError("since date is required for list action"), nil
 		}
-		if args.Ref == "" {
-			return mcp.NewToolResultError("ref is required for list action"), nil
-		}
-		return listCommits(ctx, args.ProjectPath, args.ListOptions.Since, args.ListOptions.Until, args.Ref)
+		return listCommits(ctx, args.ProjectPath, args.ListOptions.Since, args.ListOptions.Until, args.Ref, args.Timezone, args.ListOptions.IncludeMergeRequests, args.ListOptions.MaxResults)
 		
 	case "search":
 		return searchCommits(ctx, args.ProjectPath, args.SearchOptions.Author, args.SearchOptions.Path, 
-			args.SearchOptions.Since, args.SearchOptions.Until, args.Ref)
+			args.SearchOptions.Since, args.SearchOptions.Until, args.Ref, args.Timezone, args.SearchOptions.MaxResults)
 		
 	case "get_details":
 		if args.CommitSHA == "" {
 			return mcp.NewToolResultError("commit_sha is required for get_details action"), nil
 		}
-		return getCommitDetails(ctx, args.ProjectPath, args.CommitSHA)
+		return getCommitDetails(ctx, args.ProjectPath, args.CommitSHA, args.DetailsOptions.Raw)
 		
 	case "get_comments":
 		if args.CommitSHA == "" {
@@ -275,8 +539,14 @@ func commitsManagementHandler(ctx context.Context, request mcp.CallToolRequest,
 		}
 		return getCommitRefs(ctx, args.ProjectPath, args.CommitSHA, args.RefsOptions.Type)
 		
+	case "last_modified":
+		if len(args.LastModifiedOptions.FilePaths) == 0 {
+			return mcp.NewToolResultError("file_paths is required for last_modified action"), nil
+		}
+		return lastModifiedFiles(ctx, args.ProjectPath, args.Ref, args.LastModifiedOptions.FilePaths)
+		
 	default:
-		return mcp.NewToolResultError(fmt.Sprintf("invalid action: %s. Valid actions are: list, search, get_details, get_comments, post_comment, get_merge_requests, get_refs", args.Action)), nil
+		return mcp.NewToolResultError(fmt.Sprintf("invalid action: %s. Valid actions are: list, search, get_details, get_comments, post_comment, get_merge_requests, get_refs, last_modified", args.Action)), nil
 	}
 }
 
@@ -286,6 +556,13 @@ func commitOperationsHandler(ctx context.Context, request mcp.CallToolRequest, a
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with cherry-picking the commit."), nil
 		}
+		if args.CherryPickOptions.CreateMergeRequest {
+			if args.CherryPickOptions.DryRun {
+				return mcp.NewToolResultError("dry_run cannot be combined with create_merge_request"), nil
+			}
+			return cherryPickViaMergeRequest(ctx, args.ProjectPath, args.CommitSHA, args.Branch,
+				args.CherryPickOptions.NewBranch, args.CherryPickOptions.Message)
+		}
 		return cherryPickCommit(ctx, args.ProjectPath, args.CommitSHA, args.Branch,
 			args.CherryPickOptions.DryRun, args.CherryPickOptions.Message)
 		
@@ -293,6 +570,9 @@ func commitOperationsHandler(ctx context.Context, request mcp.CallToolRequest, a
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with reverting the commit."), nil
 		}
+		if args.RevertOptions.CreateMergeRequest {
+			return revertViaMergeRequest(ctx, args.ProjectPath, args.CommitSHA, args.Branch, args.RevertOptions.NewBranch)
+		}
 		return revertCommit(ctx, args.ProjectPath, args.CommitSHA, args.Branch)
 		
 	default:
@@ -301,15 +581,28 @@ func commitOperationsHandler(ctx context.Context, request mcp.CallToolRequest, a
 }
 
 // Direct implementation functions (no more legacy handlers)
-func getFileContent(ctx context.Context, projectPath, filePath, ref string) (*mcp.CallToolResult, error) {
+// defaultBranch returns the project's default branch, used when no ref is given
+func defaultBranch(ctx context.Context, projectPath string) (string, error) {
+	project, _, err := util.GitlabClientFromContext(ctx).Projects.GetProject(projectPath, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("failed to get project: %v", err)
+	}
+	return project.DefaultBranch, nil
+}
+
+func getFileContent(ctx context.Context, projectPath, filePath, ref string, lineStart, lineEnd int) (*mcp.CallToolResult, error) {
 	if ref == "" {
-		ref = "develop" // Default ref if not provided
+		branch, err := defaultBranch(ctx, projectPath)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref = branch
 	}
 
 	// Get raw file content
-	fileContent, _, err := util.GitlabClient().RepositoryFiles.GetRawFile(projectPath, filePath, &gitlab.GetRawFileOptions{
+	fileContent, _, err := util.GitlabClientFromContext(ctx).RepositoryFiles.GetRawFile(projectPath, filePath, &gitlab.GetRawFileOptions{
 		Ref: gitlab.Ptr(ref),
-	})
+	}, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get file content: %v; maybe wrong ref?", err)), nil
 	}
@@ -319,45 +612,146 @@ func getFileContent(ctx context.Context, projectPath, filePath, ref string) (*mc
 	// Write file information
 	result.WriteString(fmt.Sprintf("File: %s\n", filePath))
 	result.WriteString(fmt.Sprintf("Ref: %s\n", ref))
+
+	if lineStart == 0 && lineEnd == 0 {
+		result.WriteString("Content:\n")
+		result.WriteString(string(fileContent))
+		return mcp.NewToolResultText(result.String()), nil
+	}
+
+	// Return only the requested line range, prefixed with line numbers
+	lines := strings.Split(string(fileContent), "\n")
+	if lineStart == 0 {
+		lineStart = 1
+	}
+	if lineEnd == 0 || lineEnd > len(lines) {
+		lineEnd = len(lines)
+	}
+	if lineStart > len(lines) {
+		return mcp.NewToolResultError(fmt.Sprintf("line_start %d is beyond the end of the file (%d lines)", lineStart, len(lines))), nil
+	}
+
+	result.WriteString(fmt.Sprintf("Lines: %d-%d of %d\n", lineStart, lineEnd, len(lines)))
 	result.WriteString("Content:\n")
-	result.WriteString(string(fileContent))
+	for i := lineStart; i <= lineEnd; i++ {
+		result.WriteString(fmt.Sprintf("%d: %s\n", i, lines[i-1]))
+	}
 
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func listCommits(ctx context.Context, projectPath, since, until, ref string) (*mcp.CallToolResult, error) {
+func getFileBlame(ctx context.Context, projectPath, filePath, ref string, lineStart, lineEnd int) (*mcp.CallToolResult, error) {
+	if ref == "" {
+		branch, err := defaultBranch(ctx, projectPath)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref = branch
+	}
+
+	opt := &gitlab.GetFileBlameOptions{
+		Ref: gitlab.Ptr(ref),
+	}
+	// The API needs both ends of a range
+	if lineStart > 0 || lineEnd > 0 {
+		if lineStart == 0 {
+			lineStart = 1
+		}
+		if lineEnd == 0 {
+			return mcp.NewToolResultError("line_end is required when blaming a line range"), nil
+		}
+		opt.RangeStart = gitlab.Ptr(lineStart)
+		opt.RangeEnd = gitlab.Ptr(lineEnd)
+	}
+
+	ranges, _, err := util.GitlabClientFromContext(ctx).RepositoryFiles.GetFileBlame(projectPath, filePath, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get file blame: %v; maybe wrong ref?", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("File: %s\n", filePath))
+	result.WriteString(fmt.Sprintf("Ref: %s\n\n", ref))
+
+	line := 1
+	if lineStart > 0 {
+		line = lineStart
+	}
+	for _, blame := range ranges {
+		first, last := line, line+len(blame.Lines)-1
+		date := ""
+		if blame.Commit.AuthoredDate != nil {
+			date = blame.Commit.AuthoredDate.Format("2006-01-02 15:04:05")
+		}
+		summary, _, _ := strings.Cut(blame.Commit.Message, "\n")
+
+		result.WriteString(fmt.Sprintf("Lines %d-%d: %s %s <%s> %s - %s\n", first, last, shortSHA(blame.Commit.ID), blame.Commit.AuthorName, blame.Commit.AuthorEmail, date, summary))
+		for _, text := range blame.Lines {
+			result.WriteString(fmt.Sprintf("%d: %s\n", line, text))
+			line++
+		}
+		result.WriteString("\n")
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func listCommits(ctx context.Context, projectPath, since, until, ref, timezone string, includeMergeRequests bool, maxResults int) (*mcp.CallToolResult, error) {
+	loc, err := util.LoadTimezone(timezone)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	if until == "" {
-		until = time.Now().Format("2006-01-02")
+		until = time.Now().In(loc).Format("2006-01-02")
+	}
+
+	since, err = util.ResolveDate(since, loc)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid since date: %v", err)), nil
+	}
+	until, err = util.ResolveDate(until, loc)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid until date: %v", err)), nil
 	}
 
 	if ref == "" {
-		ref = "develop" // Default ref if not provided
+		branch, err := defaultBranch(ctx, projectPath)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref = branch
 	}
 
-	sinceTime, err := time.Parse("2006-01-02", since)
+	sinceTime, err := time.ParseInLocation("2006-01-02", since, loc)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("invalid since date: %v", err)), nil
 	}
 
-	untilTime, err := time.Parse("2006-01-02 15:04:05", until+" 23:00:00")
+	untilTime, err := time.ParseInLocation("2006-01-02 15:04:05", until+" 23:59:59", loc)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("invalid until date: %v", err)), nil
 	}
 
 	opt := &gitlab.ListCommitsOptions{
-		Since:   gitlab.Ptr(sinceTime),
-		Until:   gitlab.Ptr(untilTime),
-		RefName: gitlab.Ptr(ref),
+		Since:       gitlab.Ptr(sinceTime),
+		Until:       gitlab.Ptr(untilTime),
+		RefName:     gitlab.Ptr(ref),
+		ListOptions: gitlab.ListOptions{PerPage: util.DefaultPerPage(100)},
+	}
+
+	if includeMergeRequests && (maxResults == 0 || maxResults > maxAnnotatedCommits) {
+		maxResults = maxAnnotatedCommits
 	}
 
-	commits, _, err := util.GitlabClient().Commits.ListCommits(projectPath, opt)
+	commits, truncated, err := listCommitPages(ctx, projectPath, opt, maxResults)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to list commits: %v", err)), nil
 	}
 
 	var result strings.Builder
-	result.WriteString(fmt.Sprintf("Commits for project %s between %s and %s (ref: %s):\n\n",
-		projectPath, since, until, ref))
+	result.WriteString(fmt.Sprintf("Commits for project %s between %s and %s %s (ref: %s):\n\n",
+		projectPath, since, until, loc, ref))
 
 	for _, commit := range commits {
 		result.WriteString(fmt.Sprintf("Commit: %s\n", commit.ID))
@@ -371,29 +765,85 @@ func listCommits(ctx context.Context, projectPath, since, until, ref string) (*m
 			result.WriteString(fmt.Sprintf("  SHA: %s\n", commit.LastPipeline.SHA))
 			result.WriteString(fmt.Sprintf("  Created: %s\n", commit.LastPipeline.CreatedAt.Format("2006-01-02 15:04:05")))
 		}
+		if includeMergeRequests {
+			result.WriteString(formatCommitMergeRequests(ctx, projectPath, commit.ID))
+		}
 		result.WriteString("\n")
 	}
 
+	switch {
+	case truncated && includeMergeRequests && len(commits) == maxAnnotatedCommits:
+		result.WriteString(fmt.Sprintf("⚠️ Merge requests are looked up for at most %d commits, more match. Narrow the date range or drop include_merge_requests.\n", maxAnnotatedCommits))
+	case truncated:
+		result.WriteString(fmt.Sprintf("⚠️ Showing the first %d commits, more match. Narrow the date range or raise max_results.\n", len(commits)))
+	}
+
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func getCommitDetails(ctx context.Context, projectPath, commitSHA string) (*mcp.CallToolResult, error) {
-	commit, _, err := util.GitlabClient().Commits.GetCommit(projectPath, commitSHA, nil)
+// Default cap on commits gathered across pages by list and search
+const defaultMaxListedCommits = 500
+
+// Looking up merge requests needs one API call per commit, so include_merge_requests lists at most this many
+const maxAnnotatedCommits = 50
+
+// listCommitPages pages through commits until none are left or maxResults is
+// reached, reporting whether more commits matched than were returned.
+func listCommitPages(ctx context.Context, projectPath string, opt *gitlab.ListCommitsOptions, maxResults int) ([]*gitlab.Commit, bool, error) {
+	if maxResults == 0 {
+		maxResults = defaultMaxListedCommits
+	}
+
+	return util.CollectPages(&opt.ListOptions, maxResults, func() ([]*gitlab.Commit, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).Commits.ListCommits(projectPath, opt, gitlab.WithContext(ctx))
+	})
+}
+
+// formatCommitMergeRequests returns the "Merge Requests" line for a commit.
+// Lookup failures are reported inline so one bad commit doesn't fail the list.
+func formatCommitMergeRequests(ctx context.Context, projectPath, commitSHA string) string {
+	mrs, _, err := util.GitlabClientFromContext(ctx).Commits.ListMergeRequestsByCommit(projectPath, commitSHA, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Sprintf("Merge Requests: lookup failed: %v\n", err)
+	}
+	if len(mrs) == 0 {
+		return "Merge Requests: none\n"
+	}
+
+	var refs []string
+	for _, mr := range mrs {
+		refs = append(refs, fmt.Sprintf("!%d %s (%s)", mr.IID, mr.Title, mr.State))
+	}
+	return fmt.Sprintf("Merge Requests: %s\n", strings.Join(refs, ", "))
+}
+
+func getCommitDetails(ctx context.Context, projectPath, commitSHA string, raw bool) (*mcp.CallToolResult, error) {
+	commit, _, err := util.GitlabClientFromContext(ctx).Commits.GetCommit(projectPath, commitSHA, nil, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get commit details: %v", err)), nil
 	}
 
 	opt := &gitlab.GetCommitDiffOptions{
 		ListOptions: gitlab.ListOptions{
-			PerPage: 100,
+			PerPage: util.DefaultPerPage(100),
 		},
 	}
 
-	diffs, _, err := util.GitlabClient().Commits.GetCommitDiff(projectPath, commitSHA, opt)
+	diffs, err := util.AllPages(&opt.ListOptions, func() ([]*commitDiff, *gitlab.Response, error) {
+		return getCommitDiff(ctx, projectPath, commitSHA, opt)
+	})
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get commit diffs: %v", err)), nil
 	}
 
+	if raw {
+		patch, err := rawUnifiedDiff(diffs)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to build raw diff: %v", err)), nil
+		}
+		return mcp.NewToolResultText(patch), nil
+	}
+
 	var result strings.Builder
 	result.WriteString(fmt.Sprintf("Commit: %s\n", commit.ShortID))
 	result.WriteString(fmt.Sprintf("Author: %s\n", commit.AuthorName))
@@ -410,7 +860,8 @@ func getCommitDetails(ctx context.Context, projectPath, commitSHA string) (*mcp.
 	}
 
 	result.WriteString("Diffs:\n")
-	for _, diff := range diffs {
+	for _, d := range diffs {
+		diff := &d.Diff
 		result.WriteString(fmt.Sprintf("File: %s\n", diff.NewPath))
 		result.WriteString(fmt.Sprintf("Status: %s\n", getDiffStatus(diff)))
 
@@ -425,6 +876,87 @@ func getCommitDetails(ctx context.Context, projectPath, commitSHA string) (*mcp.
 	return mcp.NewToolResultText(result.String()), nil
 }
 
+// commitDiff is a commit diff entry with the flags GitLab sets when it leaves
+// the hunks out, which gitlab.Diff doesn't decode
+type commitDiff struct {
+	gitlab.Diff
+	Collapsed bool `json:"collapsed"`
+	TooLarge  bool `json:"too_large"`
+}
+
+// getCommitDiff fetches one page of a commit's diff
+func getCommitDiff(ctx context.Context, projectPath, commitSHA string, opt *gitlab.GetCommitDiffOptions) ([]*commitDiff, *gitlab.Response, error) {
+	client := util.GitlabClientFromContext(ctx)
+	u := fmt.Sprintf("projects/%s/repository/commits/%s/diff", gitlab.PathEscape(projectPath), url.PathEscape(commitSHA))
+	req, err := client.NewRequest(http.MethodGet, u, opt, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var diffs []*commitDiff
+	resp, err := client.Do(req, &diffs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return diffs, resp, nil
+}
+
+// rawUnifiedDiff rebuilds a patch git apply accepts. The API only returns the
+// hunks of each file, so the git headers are recreated from the diff metadata.
+// Files whose hunks GitLab left out fail the whole patch rather than silently
+// dropping their changes.
+func rawUnifiedDiff(diffs []*commitDiff) (string, error) {
+	var patch strings.Builder
+	for _, d := range diffs {
+		diff := &d.Diff
+		switch {
+		case d.TooLarge:
+			return "", fmt.Errorf("the diff of %s is too large for the API", diff.NewPath)
+		case d.Collapsed:
+			return "", fmt.Errorf("the diff of %s was collapsed by the API", diff.NewPath)
+		case diff.Diff == "" && !diff.NewFile && !diff.DeletedFile && !diff.RenamedFile && diff.AMode == diff.BMode:
+			// A modified file always has hunks, unless it's binary
+			return "", fmt.Errorf("the API returned no diff for %s", diff.NewPath)
+		case diff.Diff == "" && (diff.NewFile || diff.DeletedFile):
+			// A binary file looks the same as an empty one, and headers alone
+			// would make git apply create or delete an empty file
+			return "", fmt.Errorf("the API returned no diff for %s, which may be a binary file", diff.NewPath)
+		}
+
+		patch.WriteString(fmt.Sprintf("diff --git a/%s b/%s\n", diff.OldPath, diff.NewPath))
+		switch {
+		case diff.NewFile:
+			patch.WriteString(fmt.Sprintf("new file mode %s\n", diff.BMode))
+		case diff.DeletedFile:
+			patch.WriteString(fmt.Sprintf("deleted file mode %s\n", diff.AMode))
+		case diff.AMode != diff.BMode:
+			patch.WriteString(fmt.Sprintf("old mode %s\nnew mode %s\n", diff.AMode, diff.BMode))
+		}
+		if diff.RenamedFile {
+			patch.WriteString(fmt.Sprintf("rename from %s\nrename to %s\n", diff.OldPath, diff.NewPath))
+		}
+
+		// Renames and mode changes without content changes have no hunks
+		if diff.Diff == "" {
+			continue
+		}
+
+		oldPath, newPath := "a/"+diff.OldPath, "b/"+diff.NewPath
+		if diff.NewFile {
+			oldPath = "/dev/null"
+		}
+		if diff.DeletedFile {
+			newPath = "/dev/null"
+		}
+		patch.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", oldPath, newPath))
+		patch.WriteString(diff.Diff)
+		if !strings.HasSuffix(diff.Diff, "\n") {
+			patch.WriteString("\n")
+		}
+	}
+	return patch.String(), nil
+}
+
 func getDiffStatus(diff *gitlab.Diff) string {
 	if diff.NewFile {
 		return "Added"
@@ -438,9 +970,14 @@ func getDiffStatus(diff *gitlab.Diff) string {
 	return "Modified"
 }
 
-func searchCommits(ctx context.Context, projectPath, author, path, since, until, ref string) (*mcp.CallToolResult, error) {
+func searchCommits(ctx context.Context, projectPath, author, path, since, until, ref, timezone string, maxResults int) (*mcp.CallToolResult, error) {
+	loc, err := util.LoadTimezone(timezone)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	opt := &gitlab.ListCommitsOptions{
-		ListOptions: gitlab.ListOptions{PerPage: 100},
+		ListOptions: gitlab.ListOptions{PerPage: util.DefaultPerPage(100)},
 	}
 
 	if author != "" {
@@ -449,14 +986,23 @@ func searchCommits(ctx context.Context, projectPath, author, path, since, until,
 	if path != "" {
 		opt.Path = gitlab.Ptr(path)
 	}
-	if ref != "" {
-		opt.RefName = gitlab.Ptr(ref)
-	} else {
-		opt.RefName = gitlab.Ptr("develop")
+	if ref == "" {
+		branch, err := defaultBranch(ctx, projectPath)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref = branch
 	}
+	opt.RefName = gitlab.Ptr(ref)
 
 	if since != "" {
-		sinceTime, err := time.Parse("2006-01-02", since)
+		resolved, err := util.ResolveDate(since, loc)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid since date: %v", err)), nil
+		}
+		since = resolved
+
+		sinceTime, err := time.ParseInLocation("2006-01-02", since, loc)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("invalid since date: %v", err)), nil
 		}
@@ -464,14 +1010,20 @@ func searchCommits(ctx context.Context, projectPath, author, path, since, until,
 	}
 
 	if until != "" {
-		untilTime, err := time.Parse("2006-01-02 15:04:05", until+" 23:00:00")
+		resolved, err := util.ResolveDate(until, loc)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid until date: %v", err)), nil
+		}
+		until = resolved
+
+		untilTime, err := time.ParseInLocation("2006-01-02 15:04:05", until+" 23:59:59", loc)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("invalid until date: %v", err)), nil
 		}
 		opt.Until = gitlab.Ptr(untilTime)
 	}
 
-	commits, _, err := util.GitlabClient().Commits.ListCommits(projectPath, opt)
+	commits, truncated, err := listCommitPages(ctx, projectPath, opt, maxResults)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to search commits: %v", err)), nil
 	}
@@ -494,11 +1046,15 @@ func searchCommits(ctx context.Context, projectPath, author, path, since, until,
 		result.WriteString(fmt.Sprintf("URL: %s\n\n", commit.WebURL))
 	}
 
+	if truncated {
+		result.WriteString(fmt.Sprintf("⚠️ Showing the first %d commits, more match. Narrow the search or raise max_results.\n", len(commits)))
+	}
+
 	return mcp.NewToolResultText(result.String()), nil
 }
 
 func getCommitComments(ctx context.Context, projectPath, commitSHA string) (*mcp.CallToolResult, error) {
-	comments, _, err := util.GitlabClient().Commits.GetCommitComments(projectPath, commitSHA, nil)
+	comments, _, err := util.GitlabClientFromContext(ctx).Commits.GetCommitComments(projectPath, commitSHA, nil, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get commit comments: %v", err)), nil
 	}
@@ -542,7 +1098,7 @@ func postCommitComment(ctx context.Context, projectPath, commitSHA, note, path s
 		opt.LineType = gitlab.Ptr(lineType)
 	}
 
-	comment, _, err := util.GitlabClient().Commits.PostCommitComment(projectPath, commitSHA, opt)
+	comment, _, err := util.GitlabClientFromContext(ctx).Commits.PostCommitComment(projectPath, commitSHA, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to post commit comment: %v", err)), nil
 	}
@@ -563,7 +1119,7 @@ func postCommitComment(ctx context.Context, projectPath, commitSHA, note, path s
 }
 
 func getCommitMergeRequests(ctx context.Context, projectPath, commitSHA string) (*mcp.CallToolResult, error) {
-	mrs, _, err := util.GitlabClient().Commits.ListMergeRequestsByCommit(projectPath, commitSHA)
+	mrs, _, err := util.GitlabClientFromContext(ctx).Commits.ListMergeRequestsByCommit(projectPath, commitSHA, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get commit merge requests: %v", err)), nil
 	}
@@ -598,7 +1154,7 @@ func cherryPickCommit(ctx context.Context, projectPath, commitSHA, branch string
 		opt.Message = gitlab.Ptr(message)
 	}
 
-	commit, _, err := util.GitlabClient().Commits.CherryPickCommit(projectPath, commitSHA, opt)
+	commit, _, err := util.GitlabClientFromContext(ctx).Commits.CherryPickCommit(projectPath, commitSHA, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to cherry-pick commit: %v", err)), nil
 	}
@@ -619,12 +1175,102 @@ func cherryPickCommit(ctx context.Context, projectPath, commitSHA, branch string
 	return mcp.NewToolResultText(result.String()), nil
 }
 
+func cherryPickViaMergeRequest(ctx context.Context, projectPath, commitSHA, branch, newBranch, message string) (*mcp.CallToolResult, error) {
+	if newBranch == "" {
+		newBranch = fmt.Sprintf("cherry-pick-%s-into-%s", shortSHA(commitSHA), branch)
+	}
+
+	return commitViaMergeRequest(ctx, projectPath, branch, newBranch, func() (*gitlab.Commit, error) {
+		opt := &gitlab.CherryPickCommitOptions{
+			Branch: gitlab.Ptr(newBranch),
+		}
+		if message != "" {
+			opt.Message = gitlab.Ptr(message)
+		}
+		commit, _, err := util.GitlabClientFromContext(ctx).Commits.CherryPickCommit(projectPath, commitSHA, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to cherry-pick commit: %v", err)
+		}
+		return commit, nil
+	}, func(commit *gitlab.Commit) (string, string) {
+		return fmt.Sprintf("Cherry-pick \"%s\" into %s", commit.Title, branch),
+			fmt.Sprintf("Cherry-picks commit %s into `%s`.", commitSHA, branch)
+	})
+}
+
+// commitViaMergeRequest creates newBranch from target, lets apply commit onto
+// it and opens a merge request back into target, for branches that can't be
+// pushed to directly. The new branch is removed again if apply fails; if only
+// the merge request fails, the branch is kept with the commit and named in
+// the error.
+func commitViaMergeRequest(ctx context.Context, projectPath, target, newBranch string, apply func() (*gitlab.Commit, error), describe func(*gitlab.Commit) (string, string)) (*mcp.CallToolResult, error) {
+	_, _, err := util.GitlabClientFromContext(ctx).Branches.CreateBranch(projectPath, &gitlab.CreateBranchOptions{
+		Branch: gitlab.Ptr(newBranch),
+		Ref:    gitlab.Ptr(target),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to create branch %s: %v", newBranch, err)), nil
+	}
+
+	commit, err := apply()
+	if err != nil {
+		if _, delErr := util.GitlabClientFromContext(ctx).Branches.DeleteBranch(projectPath, newBranch, gitlab.WithContext(ctx)); delErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("%v (branch %s was left behind: %v)", err, newBranch, delErr)), nil
+		}
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	title, description := describe(commit)
+	mrResult, err := createMergeRequestHandler(ctx, mcp.CallToolRequest{}, CreateMergeRequestArgs{
+		ProjectPath:  projectPath,
+		SourceBranch: newBranch,
+		TargetBranch: target,
+		Title:        title,
+		Description:  description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if mrResult.IsError {
+		return mcp.NewToolResultError(fmt.Sprintf("%s\nCommit %s was kept on new branch %s; open a merge request from it into %s or delete the branch.",
+			toolResultText(mrResult), commit.ID, newBranch, target)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Committed %s to new branch %s:\n\n", commit.ShortID, newBranch))
+	result.WriteString(fmt.Sprintf("New Commit: %s\n", commit.ID))
+	result.WriteString(fmt.Sprintf("Message: %s\n", commit.Title))
+	result.WriteString(fmt.Sprintf("URL: %s\n\n", commit.WebURL))
+	result.WriteString(toolResultText(mrResult))
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// toolResultText joins the text contents of a tool result
+func toolResultText(result *mcp.CallToolResult) string {
+	var text strings.Builder
+	for _, content := range result.Content {
+		if c, ok := content.(mcp.TextContent); ok {
+			text.WriteString(c.Text)
+		}
+	}
+	return text.String()
+}
+
+// shortSHA abbreviates a commit SHA the way GitLab displays it
+func shortSHA(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
+
 func revertCommit(ctx context.Context, projectPath, commitSHA, branch string) (*mcp.CallToolResult, error) {
 	opt := &gitlab.RevertCommitOptions{
 		Branch: gitlab.Ptr(branch),
 	}
 
-	commit, _, err := util.GitlabClient().Commits.RevertCommit(projectPath, commitSHA, opt)
+	commit, _, err := util.GitlabClientFromContext(ctx).Commits.RevertCommit(projectPath, commitSHA, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to revert commit: %v", err)), nil
 	}
@@ -640,34 +1286,66 @@ func revertCommit(ctx context.Context, projectPath, commitSHA, branch string) (*
 	return mcp.NewToolResultText(result.String()), nil
 }
 
+func revertViaMergeRequest(ctx context.Context, projectPath, commitSHA, branch, newBranch string) (*mcp.CallToolResult, error) {
+	// The MR title names the reverted commit, not the revert commit
+	reverted, _, err := util.GitlabClientFromContext(ctx).Commits.GetCommit(projectPath, commitSHA, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get commit %s: %v", commitSHA, err)), nil
+	}
+
+	if newBranch == "" {
+		newBranch = fmt.Sprintf("revert-%s", reverted.ShortID)
+	}
+
+	return commitViaMergeRequest(ctx, projectPath, branch, newBranch, func() (*gitlab.Commit, error) {
+		commit, _, err := util.GitlabClientFromContext(ctx).Commits.RevertCommit(projectPath, commitSHA, &gitlab.RevertCommitOptions{
+			Branch: gitlab.Ptr(newBranch),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to revert commit: %v", err)
+		}
+		return commit, nil
+	}, func(*gitlab.Commit) (string, string) {
+		return fmt.Sprintf("Revert %s \"%s\"", reverted.ShortID, reverted.Title),
+			fmt.Sprintf("Reverts commit %s on `%s`.", reverted.ID, branch)
+	})
+}
+
 func getCommitRefs(ctx context.Context, projectPath, commitSHA, refType string) (*mcp.CallToolResult, error) {
 	opt := &gitlab.GetCommitRefsOptions{}
 	if refType != "" {
 		opt.Type = gitlab.Ptr(refType)
 	}
 
-	refs, _, err := util.GitlabClient().Commits.GetCommitRefs(projectPath, commitSHA, opt)
+	refs, _, err := util.GitlabClientFromContext(ctx).Commits.GetCommitRefs(projectPath, commitSHA, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get commit refs: %v", err)), nil
 	}
 
+	// Only show the sections matching the requested type filter
+	showBranches := refType == "" || refType == "branch"
+	showTags := refType == "" || refType == "tag"
+
+	branches := make([]string, 0)
+	tags := make([]string, 0)
+	for _, ref := range refs {
+		if ref.Type == "branch" && showBranches {
+			branches = append(branches, ref.Name)
+		} else if ref.Type == "tag" && showTags {
+			tags = append(tags, ref.Name)
+		}
+	}
+
 	var result strings.Builder
-	result.WriteString(fmt.Sprintf("References containing commit %s:\n\n", commitSHA))
+	if refType != "" {
+		result.WriteString(fmt.Sprintf("References containing commit %s (type: %s):\n\n", commitSHA, refType))
+	} else {
+		result.WriteString(fmt.Sprintf("References containing commit %s:\n\n", commitSHA))
+	}
 
-	if len(refs) == 0 {
+	if len(branches) == 0 && len(tags) == 0 {
 		result.WriteString("No references found.\n")
 	} else {
-		branches := make([]string, 0)
-		tags := make([]string, 0)
-
-		for _, ref := range refs {
-			if ref.Type == "branch" {
-				branches = append(branches, ref.Name)
-			} else if ref.Type == "tag" {
-				tags = append(tags, ref.Name)
-			}
-		}
-
 		if len(branches) > 0 {
 			result.WriteString("Branches:\n")
 			for _, branch := range branches {
@@ -685,4 +1363,366 @@ func getCommitRefs(ctx context.Context, projectPath, commitSHA, refType string)
 	}
 
 	return mcp.NewToolResultText(result.String()), nil
-}
\ No newline at end of file
+}
+
+// lastModifiedFiles reports the most recent commit touching each path on ref.
+// Paths are looked up one by one so a missing file doesn't fail the whole report.
+func lastModifiedFiles(ctx context.Context, projectPath, ref string, filePaths []string) (*mcp.CallToolResult, error) {
+	var refName *string
+	if ref != "" {
+		refName = gitlab.Ptr(ref)
+	}
+
+	var result strings.Builder
+	if ref != "" {
+		result.WriteString(fmt.Sprintf("Last modified files in %s (ref: %s):\n\n", projectPath, ref))
+	} else {
+		result.WriteString(fmt.Sprintf("Last modified files in %s (default branch):\n\n", projectPath))
+	}
+
+	for _, filePath := range filePaths {
+		commits, _, err := util.GitlabClientFromContext(ctx).Commits.ListCommits(projectPath, &gitlab.ListCommitsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 1},
+			RefName:     refName,
+			Path:        gitlab.Ptr(filePath),
+		}, gitlab.WithContext(ctx))
+
+		result.WriteString(fmt.Sprintf("File: %s\n", filePath))
+		switch {
+		case err != nil:
+			result.WriteString(fmt.Sprintf("Error: %v\n\n", err))
+			continue
+		case len(commits) == 0:
+			result.WriteString("No commits found (file may not exist on this ref)\n\n")
+			continue
+		}
+
+		commit := commits[0]
+		result.WriteString(fmt.Sprintf("SHA: %s\n", commit.ID))
+		result.WriteString(fmt.Sprintf("Author: %s <%s>\n", commit.AuthorName, commit.AuthorEmail))
+		if commit.CommittedDate != nil {
+			result.WriteString(fmt.Sprintf("Date: %s\n", commit.CommittedDate.Format("2006-01-02 15:04:05")))
+		}
+		result.WriteString(fmt.Sprintf("Message: %s\n\n", commit.Title))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func refStatusHandler(ctx context.Context, request mcp.CallToolRequest, args RefStatusArgs) (*mcp.CallToolResult, error) {
+	compareTo := args.CompareTo
+	if compareTo == "" {
+		branch, err := defaultBranch(ctx, args.ProjectPath)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		compareTo = branch
+	}
+
+	commit, _, err := util.GitlabClientFromContext(ctx).Commits.GetCommit(args.ProjectPath, args.Ref, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get latest commit for %s: %v", args.Ref, err)), nil
+	}
+
+	// Commits on ref that are not on the base branch
+	ahead, _, err := util.GitlabClientFromContext(ctx).Repositories.Compare(args.ProjectPath, &gitlab.CompareOptions{
+		From: gitlab.Ptr(compareTo),
+		To:   gitlab.Ptr(args.Ref),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to compare %s with %s: %v", args.Ref, compareTo, err)), nil
+	}
+
+	// Commits on the base branch that are not on ref
+	behind, _, err := util.GitlabClientFromContext(ctx).Repositories.Compare(args.ProjectPath, &gitlab.CompareOptions{
+		From: gitlab.Ptr(args.Ref),
+		To:   gitlab.Ptr(compareTo),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to compare %s with %s: %v", compareTo, args.Ref, err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Ref Status: %s\n\n", args.Ref))
+
+	result.WriteString("Latest Commit:\n")
+	result.WriteString(fmt.Sprintf("SHA: %s\n", commit.ID))
+	result.WriteString(fmt.Sprintf("Title: %s\n", commit.Title))
+	result.WriteString(fmt.Sprintf("Author: %s <%s>\n", commit.AuthorName, commit.AuthorEmail))
+	if commit.CommittedDate != nil {
+		result.WriteString(fmt.Sprintf("Committed: %s\n", commit.CommittedDate.Format("2006-01-02 15:04:05")))
+	}
+
+	result.WriteString("\nPipeline:\n")
+	if commit.LastPipeline != nil {
+		result.WriteString(fmt.Sprintf("ID: %d\n", commit.LastPipeline.ID))
+		result.WriteString(fmt.Sprintf("Status: %s\n", commit.LastPipeline.Status))
+		result.WriteString(fmt.Sprintf("URL: %s\n", commit.LastPipeline.WebURL))
+	} else {
+		result.WriteString("No pipeline found for the latest commit\n")
+	}
+
+	result.WriteString(fmt.Sprintf("\nCompared to %s:\n", compareTo))
+	result.WriteString(fmt.Sprintf("Ahead: %d commit(s)\n", len(ahead.Commits)))
+	result.WriteString(fmt.Sprintf("Behind: %d commit(s)\n", len(behind.Commits)))
+	if len(behind.Commits) == 0 {
+		result.WriteString(fmt.Sprintf("Up to date with %s\n", compareTo))
+	} else {
+		result.WriteString(fmt.Sprintf("Needs rebase or merge of %s to be up to date\n", compareTo))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func compareRefsHandler(ctx context.Context, request mcp.CallToolRequest, args CompareRefsArgs) (*mcp.CallToolResult, error) {
+	compare, _, err := util.GitlabClientFromContext(ctx).Repositories.Compare(args.ProjectPath, &gitlab.CompareOptions{
+		From:     gitlab.Ptr(args.From),
+		To:       gitlab.Ptr(args.To),
+		Straight: gitlab.Ptr(args.Straight),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to compare %s with %s: %v", args.To, args.From, err)), nil
+	}
+
+	var result strings.Builder
+	separator := "..."
+	if args.Straight {
+		separator = ".."
+	}
+	result.WriteString(fmt.Sprintf("Comparing %s%s%s\n", args.From, separator, args.To))
+	if compare.WebURL != "" {
+		result.WriteString(fmt.Sprintf("URL: %s\n", compare.WebURL))
+	}
+	if compare.CompareSameRef {
+		result.WriteString("\nBoth refs point to the same commit, nothing to compare\n")
+		return mcp.NewToolResultText(result.String()), nil
+	}
+	if compare.CompareTimeout {
+		result.WriteString("⚠️ GitLab timed out computing the comparison, the results below may be incomplete\n")
+	}
+
+	result.WriteString(fmt.Sprintf("\nCommits (%d):\n", len(compare.Commits)))
+	for _, commit := range compare.Commits {
+		date := ""
+		if commit.CommittedDate != nil {
+			date = commit.CommittedDate.Format("2006-01-02 15:04:05")
+		}
+		result.WriteString(fmt.Sprintf("- %s %s (%s, %s)\n", commit.ShortID, commit.Title, commit.AuthorName, date))
+	}
+
+	result.WriteString(fmt.Sprintf("\nChanged Files (%d):\n", len(compare.Diffs)))
+	for _, diff := range compare.Diffs {
+		added, removed := countDiffLines(diff.Diff)
+		result.WriteString(fmt.Sprintf("- %s: %s (+%d/-%d)\n", diff.NewPath, getDiffStatus(diff), added, removed))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func commitStatusesHandler(ctx context.Context, request mcp.CallToolRequest, args CommitStatusesArgs) (*mcp.CallToolResult, error) {
+	switch args.Action {
+	case "list":
+		opt := &gitlab.GetCommitStatusesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: util.DefaultPerPage(100)},
+			All:         gitlab.Ptr(true),
+		}
+		if args.Name != "" {
+			opt.Name = gitlab.Ptr(args.Name)
+		}
+		if args.Ref != "" {
+			opt.Ref = gitlab.Ptr(args.Ref)
+		}
+
+		statuses, _, err := util.GitlabClientFromContext(ctx).Commits.GetCommitStatuses(args.ProjectPath, args.CommitSHA, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to list commit statuses: %v", err)), nil
+		}
+		if len(statuses) == 0 {
+			return mcp.NewToolResultText(fmt.Sprintf("No statuses found for commit %s", args.CommitSHA)), nil
+		}
+
+		var result strings.Builder
+		result.WriteString(fmt.Sprintf("Statuses for commit %s (%d):\n\n", args.CommitSHA, len(statuses)))
+		for _, status := range statuses {
+			result.WriteString(formatCommitStatus(status))
+			result.WriteString("\n")
+		}
+		return mcp.NewToolResultText(result.String()), nil
+	case "set":
+		if args.State == "" {
+			return mcp.NewToolResultError("state is required for set action"), nil
+		}
+		if !args.Confirmed {
+			return mcp.NewToolResultError(fmt.Sprintf("This operation requires confirmation. Please set 'confirmed: true' to proceed with setting the %s status on commit %s.", args.State, args.CommitSHA)), nil
+		}
+
+		opt := &gitlab.SetCommitStatusOptions{
+			State: gitlab.BuildStateValue(args.State),
+		}
+		if args.Name != "" {
+			opt.Name = gitlab.Ptr(args.Name)
+		}
+		if args.Ref != "" {
+			opt.Ref = gitlab.Ptr(args.Ref)
+		}
+		if args.TargetURL != "" {
+			opt.TargetURL = gitlab.Ptr(args.TargetURL)
+		}
+		if args.Description != "" {
+			opt.Description = gitlab.Ptr(args.Description)
+		}
+
+		status, _, err := util.GitlabClientFromContext(ctx).Commits.SetCommitStatus(args.ProjectPath, args.CommitSHA, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to set commit status: %v", err)), nil
+		}
+		return mcp.NewToolResultText("✅ Commit status set\n\n" + formatCommitStatus(status)), nil
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("invalid action: %s. Valid actions are: list, set", args.Action)), nil
+	}
+}
+
+func formatCommitStatus(status *gitlab.CommitStatus) string {
+	result := fmt.Sprintf("Name: %s\n", status.Name)
+	result += fmt.Sprintf("Status: %s\n", status.Status)
+	if status.Ref != "" {
+		result += fmt.Sprintf("Ref: %s\n", status.Ref)
+	}
+	if status.Description != "" {
+		result += fmt.Sprintf("Description: %s\n", status.Description)
+	}
+	if status.TargetURL != "" {
+		result += fmt.Sprintf("Target URL: %s\n", status.TargetURL)
+	}
+	if status.Author.Username != "" {
+		result += fmt.Sprintf("Author: %s\n", status.Author.Username)
+	}
+	if status.CreatedAt != nil {
+		result += fmt.Sprintf("Created: %s\n", status.CreatedAt.Format("2006-01-02 15:04:05"))
+	}
+	return result
+}
+
+// readmePreference ranks README extensions, lower is preferred
+var readmePreference = map[string]int{
+	".md":       0,
+	".markdown": 1,
+	".rst":      2,
+	".txt":      3,
+	"":          4,
+}
+
+func projectReadmeHandler(ctx context.Context, request mcp.CallToolRequest, args ProjectReadmeArgs) (*mcp.CallToolResult, error) {
+	ref := args.Ref
+	if ref == "" {
+		branch, err := defaultBranch(ctx, args.ProjectPath)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref = branch
+	}
+
+	tree, _, err := util.GitlabClientFromContext(ctx).Repositories.ListTree(args.ProjectPath, &gitlab.ListTreeOptions{
+		ListOptions: gitlab.ListOptions{PerPage: util.DefaultPerPage(100)},
+		Ref:         gitlab.Ptr(ref),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list repository tree: %v", err)), nil
+	}
+
+	readmePath := ""
+	bestRank := len(readmePreference)
+	for _, node := range tree {
+		if node.Type != "blob" {
+			continue
+		}
+		name := strings.ToLower(node.Name)
+		if !strings.HasPrefix(name, "readme") {
+			continue
+		}
+		rank, ok := readmePreference[strings.TrimPrefix(name, "readme")]
+		if !ok {
+			rank = len(readmePreference)
+		}
+		if readmePath == "" || rank < bestRank {
+			readmePath = node.Path
+			bestRank = rank
+		}
+	}
+
+	if readmePath == "" {
+		return mcp.NewToolResultError(fmt.Sprintf("no README found at the root of %s (ref: %s)", args.ProjectPath, ref)), nil
+	}
+
+	content, _, err := util.GitlabClientFromContext(ctx).RepositoryFiles.GetRawFile(args.ProjectPath, readmePath, &gitlab.GetRawFileOptions{
+		Ref: gitlab.Ptr(ref),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get %s: %v", readmePath, err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("File: %s\n", readmePath))
+	result.WriteString(fmt.Sprintf("Ref: %s\n", ref))
+	result.WriteString("Content:\n")
+	result.WriteString(string(content))
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func downloadArchiveHandler(ctx context.Context, request mcp.CallToolRequest, args DownloadArchiveArgs) (*mcp.CallToolResult, error) {
+	ref := args.Ref
+	if ref == "" {
+		branch, err := defaultBranch(ctx, args.ProjectPath)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		ref = branch
+	}
+
+	format := args.Format
+	if format == "" {
+		format = "tar.gz"
+	}
+
+	maxBytes := defaultArchiveMaxBytes
+	if args.MaxBytes > 0 {
+		maxBytes = args.MaxBytes
+	}
+
+	opt := &gitlab.ArchiveOptions{
+		Format: gitlab.Ptr(format),
+		SHA:    gitlab.Ptr(ref),
+	}
+	if args.Path != "" {
+		opt.Path = gitlab.Ptr(args.Path)
+	}
+
+	// Stream the archive and stop reading at max_bytes rather than buffering all of it
+	downloadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	archive := &util.LimitedBuffer{Limit: int64(maxBytes), OnLimit: cancel}
+	_, err := util.GitlabClientFromContext(ctx).Repositories.StreamArchive(args.ProjectPath, archive, opt, gitlab.WithContext(downloadCtx))
+	truncated := errors.Is(err, util.ErrLimitExceeded)
+	if err != nil && !truncated {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to download archive: %v", err)), nil
+	}
+
+	var result strings.Builder
+	if truncated {
+		result.WriteString(fmt.Sprintf("Archive of %s at %s (%s)\n", args.ProjectPath, ref, format))
+	} else {
+		result.WriteString(fmt.Sprintf("Archive of %s at %s (%s, %d bytes)\n", args.ProjectPath, ref, format, archive.Len()))
+	}
+	if args.Path != "" {
+		result.WriteString(fmt.Sprintf("Path: %s\n", args.Path))
+	}
+	if truncated {
+		result.WriteString(fmt.Sprintf("⚠️ Truncated: showing the first %d bytes, so the archive is incomplete. Raise max_bytes or narrow it with path\n", maxBytes))
+	}
+	result.WriteString("\nContent (base64):\n\n")
+	result.WriteString(base64.StdEncoding.EncodeToString(archive.Bytes()))
+	result.WriteString("\n")
+
+	return mcp.NewToolResultText(result.String()), nil
+}
@@ -0,0 +1,214 @@
+package tools
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestListCommitsUntilTodayIncludesLateCommits(t *testing.T) {
+	now := time.Now().UTC()
+	lateCommit := time.Date(now.Year(), now.Month(), now.Day(), 23, 30, 0, 0, time.UTC)
+	commits := []map[string]any{
+		{"id": "late0000", "title": "Late night fix", "author_name": "alice", "committed_date": lateCommit.Format(time.RFC3339)},
+		{"id": "early000", "title": "Morning change", "author_name": "bob", "committed_date": lateCommit.Add(-13 * time.Hour).Format(time.RFC3339)},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		// Filter like GitLab does, so a too-early until drops the late commit
+		until, err := time.Parse(time.RFC3339, r.URL.Query().Get("until"))
+		if err != nil {
+			t.Errorf("invalid until parameter %q: %v", r.URL.Query().Get("until"), err)
+		}
+
+		var matched []map[string]any
+		for _, commit := range commits {
+			committed, _ := time.Parse(time.RFC3339, commit["committed_date"].(string))
+			if !committed.After(until) {
+				matched = append(matched, commit)
+			}
+		}
+		writeJSON(t, w, matched)
+	})
+
+	result, err := listCommits(newTestContext(t, mux), "group/project", "yesterday", now.Format("2006-01-02"), "main", "", false, 0)
+	text := resultText(t, result, err)
+
+	for _, want := range []string{"Late night fix", "Morning change"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("result missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestRawCommitDiffReadsEveryPage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/repository/commits/abc123", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"id": "abc123", "short_id": "abc123", "title": "Change"})
+	})
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/repository/commits/abc123/diff", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, []map[string]any{{"old_path": "b.go", "new_path": "b.go", "a_mode": "100644", "b_mode": "100644", "diff": "@@ -1 +1 @@\n-b\n+B\n"}})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{{"old_path": "a.go", "new_path": "a.go", "a_mode": "100644", "b_mode": "100644", "diff": "@@ -1 +1 @@\n-a\n+A\n"}})
+	})
+
+	result, err := getCommitDetails(newTestContext(t, mux), "group/project", "abc123", true)
+	text := resultText(t, result, err)
+
+	for _, want := range []string{"diff --git a/a.go b/a.go", "diff --git a/b.go b/b.go"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("patch missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestRawCommitDiffRejectsCollapsedFiles(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/repository/commits/abc123", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"id": "abc123", "short_id": "abc123", "title": "Change"})
+	})
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/repository/commits/abc123/diff", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, []map[string]any{
+			{"old_path": "a.go", "new_path": "a.go", "a_mode": "100644", "b_mode": "100644", "diff": "@@ -1 +1 @@\n-a\n+A\n"},
+			{"old_path": "big.go", "new_path": "big.go", "a_mode": "100644", "b_mode": "100644", "diff": "", "collapsed": true},
+		})
+	})
+
+	result, err := getCommitDetails(newTestContext(t, mux), "group/project", "abc123", true)
+	if err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	if !result.IsError {
+		t.Fatalf("expected an error result for a collapsed diff, got:\n%s", toolResultText(result))
+	}
+	if text := toolResultText(result); !strings.Contains(text, "big.go") {
+		t.Errorf("error doesn't name the collapsed file: %s", text)
+	}
+}
+
+func TestRawCommitDiffRejectsNewFilesWithoutHunks(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/repository/commits/abc123", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"id": "abc123", "short_id": "abc123", "title": "Add logo"})
+	})
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/repository/commits/abc123/diff", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, []map[string]any{
+			{"old_path": "logo.png", "new_path": "logo.png", "a_mode": "0", "b_mode": "100644", "new_file": true, "diff": ""},
+		})
+	})
+
+	result, err := getCommitDetails(newTestContext(t, mux), "group/project", "abc123", true)
+	if err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	if !result.IsError {
+		t.Fatalf("expected an error result for a new file without hunks, got:\n%s", toolResultText(result))
+	}
+	if text := toolResultText(result); !strings.Contains(text, "logo.png") {
+		t.Errorf("error doesn't name the file: %s", text)
+	}
+}
+
+func TestListCommitsCapsMergeRequestLookups(t *testing.T) {
+	now := time.Now().UTC()
+	var commits []map[string]any
+	for i := range 60 {
+		commits = append(commits, map[string]any{
+			"id":             fmt.Sprintf("commit%02d", i),
+			"title":          fmt.Sprintf("Change %d", i),
+			"committed_date": now.Add(-time.Duration(i) * time.Minute).Format(time.RFC3339),
+		})
+	}
+
+	var lookups atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, commits)
+	})
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/repository/commits/{sha}/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		lookups.Add(1)
+		writeJSON(t, w, []map[string]any{})
+	})
+
+	result, err := listCommits(newTestContext(t, mux), "group/project", "7d", "", "main", "", true, 0)
+	text := resultText(t, result, err)
+
+	if got := lookups.Load(); got != maxAnnotatedCommits {
+		t.Errorf("looked up merge requests for %d commits, want %d", got, maxAnnotatedCommits)
+	}
+	if !strings.Contains(text, "⚠️") {
+		t.Errorf("result doesn't report the cap:\n%s", text)
+	}
+}
+
+func TestDownloadArchiveStopsAtMaxBytes(t *testing.T) {
+	body := strings.Repeat("x", 4096)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/repository/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	})
+
+	result, err := downloadArchiveHandler(newTestContext(t, mux), mcp.CallToolRequest{}, DownloadArchiveArgs{
+		ProjectPath: "group/project",
+		Ref:         "main",
+		MaxBytes:    100,
+	})
+	text := resultText(t, result, err)
+
+	if !strings.Contains(text, "⚠️ Truncated") {
+		t.Errorf("result doesn't report truncation:\n%s", text)
+	}
+	if want := base64.StdEncoding.EncodeToString([]byte(body[:100])); !strings.Contains(text, want+"\n") {
+		t.Errorf("result doesn't hold exactly the first 100 bytes:\n%s", text)
+	}
+}
+
+func TestWriteRepositoryFileReportsItsOwnCommit(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v4/projects/group%2Fproject/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Branch  string `json:"branch"`
+			Actions []struct {
+				Action   string `json:"action"`
+				FilePath string `json:"file_path"`
+			} `json:"actions"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("invalid request body: %v", err)
+		}
+		if body.Branch != "main" || len(body.Actions) != 1 || body.Actions[0].Action != "update" || body.Actions[0].FilePath != "README.md" {
+			t.Errorf("unexpected commit request: %+v", body)
+		}
+		writeJSON(t, w, map[string]any{"id": "written1", "title": "Update README", "web_url": "https://gitlab.example.com/c/written1"})
+	})
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/repository/branches/main", func(w http.ResponseWriter, r *http.Request) {
+		// Someone else pushed after the write
+		writeJSON(t, w, map[string]any{"name": "main", "commit": map[string]any{"id": "someoneelse"}})
+	})
+
+	result, err := writeRepositoryFile(newTestContext(t, mux), RepositoryFilesArgs{
+		Action:        "update",
+		ProjectPath:   "group/project",
+		FilePath:      "README.md",
+		Branch:        "main",
+		Content:       "hello",
+		CommitMessage: "Update README",
+	})
+	text := resultText(t, result, err)
+
+	if !strings.Contains(text, "Commit: written1") {
+		t.Errorf("result doesn't report the write's commit:\n%s", text)
+	}
+}
@@ -0,0 +1,155 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/gitlab-mcp/util"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+type ResourceEventsArgs struct {
+	ProjectPath  string `json:"project_path" validate:"required,min=1,max=255"`
+	ResourceType string `json:"resource_type" validate:"required,oneof=issue merge_request"`
+	IID          int    `json:"iid" validate:"required,min=1"`
+}
+
+func RegisterResourceEventTools(s *server.MCPServer) {
+	labelEventsTool := mcp.NewTool("list_label_events",
+		mcp.WithDescription("Show the label history of an issue or merge request: who added or removed which label and when"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
+		mcp.WithString("resource_type", mcp.Required(), mcp.Description("Whether iid refers to an issue or a merge request"), mcp.Enum("issue", "merge_request")),
+		mcp.WithNumber("iid", mcp.Required(), mcp.Description("Issue or merge request IID")),
+	)
+
+	stateEventsTool := mcp.NewTool("list_state_events",
+		mcp.WithDescription("Show when and by whom an issue or merge request was closed, reopened or merged, oldest first"),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
+		mcp.WithString("resource_type", mcp.Required(), mcp.Description("Whether iid refers to an issue or a merge request"), mcp.Enum("issue", "merge_request")),
+		mcp.WithNumber("iid", mcp.Required(), mcp.Description("Issue or merge request IID")),
+	)
+
+	s.AddTool(labelEventsTool, mcp.NewTypedToolHandler(listLabelEventsHandler))
+	s.AddTool(stateEventsTool, mcp.NewTypedToolHandler(listStateEventsHandler))
+}
+
+func listLabelEventsHandler(ctx context.Context, request mcp.CallToolRequest, args ResourceEventsArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.ListLabelEventsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+
+	client := util.GitlabClientFromContext(ctx)
+	resource := resourceName(args.ResourceType, args.IID)
+
+	var events []*gitlab.LabelEvent
+	for {
+		var page []*gitlab.LabelEvent
+		var resp *gitlab.Response
+		var err error
+		if args.ResourceType == "merge_request" {
+			page, resp, err = client.ResourceLabelEvents.ListMergeRequestsLabelEvents(args.ProjectPath, args.IID, opt, gitlab.WithContext(ctx))
+		} else {
+			page, resp, err = client.ResourceLabelEvents.ListIssueLabelEvents(args.ProjectPath, args.IID, opt, gitlab.WithContext(ctx))
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to list label events of %s: %v", resource, err)), nil
+		}
+		events = append(events, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	if len(events) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No label changes on %s\n", resource)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Label history of %s:\n\n", resource))
+	for _, event := range events {
+		date := ""
+		if event.CreatedAt != nil {
+			date = event.CreatedAt.Format("2006-01-02 15:04:05")
+		}
+		verb := "added"
+		if event.Action == "remove" {
+			verb = "removed"
+		}
+		// Deleted labels come back without a name
+		label := event.Label.Name
+		if label == "" {
+			label = "(deleted label)"
+		}
+		result.WriteString(fmt.Sprintf("%s @%s %s ~%s\n", date, event.User.Username, verb, label))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func listStateEventsHandler(ctx context.Context, request mcp.CallToolRequest, args ResourceEventsArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.ListStateEventsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+
+	client := util.GitlabClientFromContext(ctx)
+	resource := resourceName(args.ResourceType, args.IID)
+
+	var events []*gitlab.StateEvent
+	for {
+		var page []*gitlab.StateEvent
+		var resp *gitlab.Response
+		var err error
+		if args.ResourceType == "merge_request" {
+			page, resp, err = client.ResourceStateEvents.ListMergeStateEvents(args.ProjectPath, args.IID, opt, gitlab.WithContext(ctx))
+		} else {
+			page, resp, err = client.ResourceStateEvents.ListIssueStateEvents(args.ProjectPath, args.IID, opt, gitlab.WithContext(ctx))
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to list state events of %s: %v", resource, err)), nil
+		}
+		events = append(events, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	if len(events) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No state changes on %s\n", resource)), nil
+	}
+
+	// Events come back in creation order, which is already chronological
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("State history of %s:\n\n", resource))
+	for _, event := range events {
+		date := ""
+		if event.CreatedAt != nil {
+			date = event.CreatedAt.Format("2006-01-02 15:04:05")
+		}
+		actor := "unknown"
+		if event.User != nil {
+			actor = "@" + event.User.Username
+		}
+		result.WriteString(fmt.Sprintf("%s %s %s\n", date, actor, event.State))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// resourceName formats an issue or merge request reference for messages
+func resourceName(resourceType string, iid int) string {
+	if resourceType == "merge_request" {
+		return fmt.Sprintf("merge request !%d", iid)
+	}
+	return fmt.Sprintf("issue #%d", iid)
+}
@@ -0,0 +1,266 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/gitlab-mcp/util"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Consolidated runner management arguments with action-based routing
+type RunnerManagementArgs struct {
+	Action      string `json:"action" validate:"required,oneof=list_all list_project get enable_project disable_project pause resume"`
+	ProjectPath string `json:"project_path,omitempty" validate:"omitempty,min=1,max=255"`
+	RunnerID    int    `json:"runner_id,omitempty" validate:"omitempty,min=1"`
+	Confirmed   bool   `json:"confirmed,omitempty"`
+
+	// List action options
+	ListOptions struct {
+		Type        string   `json:"type,omitempty" validate:"omitempty,oneof=instance_type group_type project_type"`
+		Status      string   `json:"status,omitempty" validate:"omitempty,oneof=online offline stale never_contacted"`
+		TagList     []string `json:"tag_list,omitempty"`
+		IncludeTags bool     `json:"include_tags,omitempty"`
+	} `json:"list_options,omitempty"`
+}
+
+func RegisterRunnerTools(s *server.MCPServer) {
+	runnerManagementTool := mcp.NewTool("manage_runners",
+		mcp.WithDescription("Inspect and manage CI/CD runners: list_all (administrator), list_project, get, enable_project, disable_project, pause, resume"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: list_all, list_project, get, enable_project, disable_project, pause, resume")),
+		mcp.WithString("project_path", mcp.Description("Project/repo path (required for: list_project, enable_project, disable_project)")),
+		mcp.WithNumber("runner_id", mcp.Description("Runner ID (required for: get, enable_project, disable_project, pause, resume)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for enable_project, disable_project, pause and resume actions")),
+
+		// List options
+		mcp.WithObject("list_options",
+			mcp.Description("Options for list_all and list_project actions"),
+			mcp.Properties(map[string]any{
+				"type": map[string]any{
+					"type":        "string",
+					"description": "Runner type filter",
+					"enum":        []string{"instance_type", "group_type", "project_type"},
+				},
+				"status": map[string]any{
+					"type":        "string",
+					"description": "Runner status filter",
+					"enum":        []string{"online", "offline", "stale", "never_contacted"},
+				},
+				"tag_list": map[string]any{
+					"type":        "array",
+					"description": "Only runners having all of these tags",
+					"items":       map[string]any{"type": "string"},
+				},
+				"include_tags": map[string]any{
+					"type":        "boolean",
+					"description": "Show each runner's tags (one extra API call per runner)",
+				},
+			}),
+		),
+	)
+
+	s.AddTool(runnerManagementTool, mcp.NewTypedToolHandler(runnerManagementHandler))
+}
+
+// Consolidated runner management handler
+func runnerManagementHandler(ctx context.Context, request mcp.CallToolRequest, args RunnerManagementArgs) (*mcp.CallToolResult, error) {
+	switch args.Action {
+	case "list_all":
+		return handleListAllRunners(ctx, args)
+	case "list_project":
+		if args.ProjectPath == "" {
+			return mcp.NewToolResultError("project_path is required for list_project action"), nil
+		}
+		return handleListProjectRunners(ctx, args)
+	}
+
+	if args.RunnerID == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("runner_id is required for %s action", args.Action)), nil
+	}
+
+	switch args.Action {
+	case "get":
+		details, _, err := util.GitlabClientFromContext(ctx).Runners.GetRunnerDetails(args.RunnerID, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to get runner: %v", err)), nil
+		}
+		return mcp.NewToolResultText(formatRunnerDetails(details)), nil
+	case "enable_project", "disable_project":
+		if args.ProjectPath == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("project_path is required for %s action", args.Action)), nil
+		}
+		if !args.Confirmed {
+			return mcp.NewToolResultError(fmt.Sprintf("This operation requires confirmation. Please set 'confirmed: true' to proceed with the %s action.", args.Action)), nil
+		}
+		if args.Action == "enable_project" {
+			return handleEnableProjectRunner(ctx, args)
+		}
+		return handleDisableProjectRunner(ctx, args)
+	case "pause", "resume":
+		if !args.Confirmed {
+			return mcp.NewToolResultError(fmt.Sprintf("This operation requires confirmation. Please set 'confirmed: true' to proceed with the %s action.", args.Action)), nil
+		}
+		return handleSetRunnerPaused(ctx, args.RunnerID, args.Action == "pause")
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list_all, list_project, get, enable_project, disable_project, pause, resume", args.Action)), nil
+	}
+}
+
+// Upper bound on runners gathered across pages for one list
+const maxListedRunners = 500
+
+func handleListAllRunners(ctx context.Context, args RunnerManagementArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.ListRunnersOptions{
+		ListOptions: gitlab.ListOptions{PerPage: util.DefaultPerPage(100)},
+	}
+	if args.ListOptions.Type != "" {
+		opt.Type = gitlab.Ptr(args.ListOptions.Type)
+	}
+	if args.ListOptions.Status != "" {
+		opt.Status = gitlab.Ptr(args.ListOptions.Status)
+	}
+	if len(args.ListOptions.TagList) > 0 {
+		opt.TagList = &args.ListOptions.TagList
+	}
+
+	runners, truncated, err := util.CollectPages(&opt.ListOptions, maxListedRunners, func() ([]*gitlab.Runner, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).Runners.ListAllRunners(opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list runners (administrator access required): %v", err)), nil
+	}
+
+	result := formatRunnerList(ctx, "All runners", runners, args.ListOptions.IncludeTags)
+	if truncated {
+		result += fmt.Sprintf("\nShowing the first %d runners. Narrow the type, status or tag filters to see the rest.\n", maxListedRunners)
+	}
+	return mcp.NewToolResultText(result), nil
+}
+
+func handleListProjectRunners(ctx context.Context, args RunnerManagementArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.ListProjectRunnersOptions{
+		ListOptions: gitlab.ListOptions{PerPage: util.DefaultPerPage(100)},
+	}
+	if args.ListOptions.Type != "" {
+		opt.Type = gitlab.Ptr(args.ListOptions.Type)
+	}
+	if args.ListOptions.Status != "" {
+		opt.Status = gitlab.Ptr(args.ListOptions.Status)
+	}
+	if len(args.ListOptions.TagList) > 0 {
+		opt.TagList = &args.ListOptions.TagList
+	}
+
+	runners, truncated, err := util.CollectPages(&opt.ListOptions, maxListedRunners, func() ([]*gitlab.Runner, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).Runners.ListProjectRunners(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list project runners: %v", err)), nil
+	}
+
+	result := formatRunnerList(ctx, fmt.Sprintf("Runners available to %s", args.ProjectPath), runners, args.ListOptions.IncludeTags)
+	if truncated {
+		result += fmt.Sprintf("\nShowing the first %d runners. Narrow the type, status or tag filters to see the rest.\n", maxListedRunners)
+	}
+	return mcp.NewToolResultText(result), nil
+}
+
+func handleEnableProjectRunner(ctx context.Context, args RunnerManagementArgs) (*mcp.CallToolResult, error) {
+	runner, _, err := util.GitlabClientFromContext(ctx).Runners.EnableProjectRunner(args.ProjectPath, &gitlab.EnableProjectRunnerOptions{
+		RunnerID: args.RunnerID,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to enable runner: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("✅ Runner #%d (%s) enabled for project %s\n", runner.ID, runner.Description, args.ProjectPath)), nil
+}
+
+func handleDisableProjectRunner(ctx context.Context, args RunnerManagementArgs) (*mcp.CallToolResult, error) {
+	_, err := util.GitlabClientFromContext(ctx).Runners.DisableProjectRunner(args.ProjectPath, args.RunnerID, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to disable runner: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("✅ Runner #%d disabled for project %s\n", args.RunnerID, args.ProjectPath)), nil
+}
+
+func handleSetRunnerPaused(ctx context.Context, runnerID int, paused bool) (*mcp.CallToolResult, error) {
+	details, _, err := util.GitlabClientFromContext(ctx).Runners.UpdateRunnerDetails(runnerID, &gitlab.UpdateRunnerDetailsOptions{
+		Paused: gitlab.Ptr(paused),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to update runner: %v", err)), nil
+	}
+
+	action := "resumed"
+	if paused {
+		action = "paused"
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("✅ Runner %s\n\n%s", action, formatRunnerDetails(details))), nil
+}
+
+// formatRunnerList prints one line per runner. Tags are only returned by the
+// runner details endpoint, so with includeTags each runner is looked up individually.
+func formatRunnerList(ctx context.Context, title string, runners []*gitlab.Runner, includeTags bool) string {
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("%s (%d):\n\n", title, len(runners)))
+
+	if len(runners) == 0 {
+		result.WriteString("No runners found.\n")
+		return result.String()
+	}
+
+	for _, runner := range runners {
+		result.WriteString(fmt.Sprintf("#%d %s\n", runner.ID, runner.Description))
+		result.WriteString(fmt.Sprintf("   Status: %s%s\n", runnerStatusIcon(runner.Status), runner.Status))
+		result.WriteString(fmt.Sprintf("   Type: %s, Shared: %v, Paused: %v\n", runner.RunnerType, runner.IsShared, runner.Paused))
+		if !includeTags {
+			continue
+		}
+		if details, _, err := util.GitlabClientFromContext(ctx).Runners.GetRunnerDetails(runner.ID, gitlab.WithContext(ctx)); err == nil && len(details.TagList) > 0 {
+			result.WriteString(fmt.Sprintf("   Tags: %s\n", strings.Join(details.TagList, ", ")))
+		}
+	}
+
+	return result.String()
+}
+
+func formatRunnerDetails(details *gitlab.RunnerDetails) string {
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Runner #%d: %s\n", details.ID, details.Description))
+	result.WriteString(fmt.Sprintf("Status: %s%s\n", runnerStatusIcon(details.Status), details.Status))
+	result.WriteString(fmt.Sprintf("Online: %v\n", details.Online))
+	result.WriteString(fmt.Sprintf("Paused: %v\n", details.Paused))
+	result.WriteString(fmt.Sprintf("Type: %s\n", details.RunnerType))
+	result.WriteString(fmt.Sprintf("Shared: %v\n", details.IsShared))
+	result.WriteString(fmt.Sprintf("Locked: %v\n", details.Locked))
+	result.WriteString(fmt.Sprintf("Run Untagged: %v\n", details.RunUntagged))
+	if len(details.TagList) > 0 {
+		result.WriteString(fmt.Sprintf("Tags: %s\n", strings.Join(details.TagList, ", ")))
+	}
+	if details.ContactedAt != nil {
+		result.WriteString(fmt.Sprintf("Last Contact: %s\n", details.ContactedAt.Format("2006-01-02 15:04:05")))
+	}
+	if len(details.Projects) > 0 {
+		result.WriteString("Projects:\n")
+		for _, project := range details.Projects {
+			result.WriteString(fmt.Sprintf("- %s\n", project.PathWithNamespace))
+		}
+	}
+	return result.String()
+}
+
+func runnerStatusIcon(status string) string {
+	switch status {
+	case "online":
+		return "✅ "
+	case "offline", "stale":
+		return "🔴 "
+	default:
+		return ""
+	}
+}
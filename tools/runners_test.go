@@ -0,0 +1,59 @@
+package tools
+
+import (
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRunnerListLooksUpTagsOnlyWhenAsked(t *testing.T) {
+	var lookups atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/runners", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, []map[string]any{{"id": 1, "description": "docker", "status": "online"}})
+	})
+	mux.HandleFunc("/api/v4/runners/1", func(w http.ResponseWriter, r *http.Request) {
+		lookups.Add(1)
+		writeJSON(t, w, map[string]any{"id": 1, "tag_list": []string{"linux", "docker"}})
+	})
+	ctx := newTestContext(t, mux)
+
+	args := RunnerManagementArgs{Action: "list_project", ProjectPath: "group/project"}
+	result, err := handleListProjectRunners(ctx, args)
+	text := resultText(t, result, err)
+	if lookups.Load() != 0 || strings.Contains(text, "Tags:") {
+		t.Errorf("looked up tags without include_tags:\n%s", text)
+	}
+
+	args.ListOptions.IncludeTags = true
+	result, err = handleListProjectRunners(ctx, args)
+	text = resultText(t, result, err)
+	if lookups.Load() != 1 || !strings.Contains(text, "Tags: linux, docker") {
+		t.Errorf("tags missing with include_tags:\n%s", text)
+	}
+}
+
+func TestRunnerListReadsEveryPage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/runners", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, []map[string]any{{"id": 2, "description": "shell", "status": "offline"}})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		writeJSON(t, w, []map[string]any{{"id": 1, "description": "docker", "status": "online"}})
+	})
+
+	result, err := handleListProjectRunners(newTestContext(t, mux), RunnerManagementArgs{Action: "list_project", ProjectPath: "group/project"})
+	text := resultText(t, result, err)
+
+	for _, want := range []string{"(2):", "#1 docker", "#2 shell"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("result missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "Showing the first") {
+		t.Errorf("result should not be marked truncated:\n%s", text)
+	}
+}
@@ -69,7 +69,7 @@ func RegisterSearchTools(s *server.MCPServer) {
 			mcp.Description("Search query string (1-500 characters)")),
 		mcp.WithString("scope", 
 			mcp.Required(), 
-			mcp.Description("Content type: projects, merge_requests, commits, blobs, users, issues, milestones, snippets, wikis, notes")),
+			mcp.Description("Content type: projects, merge_requests, commits, blobs, users, issues, milestones, snippets (global only), wikis, notes (project only)")),
 		mcp.WithString("ref", 
 			mcp.Description("Repository branch, tag, or commit SHA (optional)")),
 		
@@ -128,7 +128,7 @@ func RegisterSearchTools(s *server.MCPServer) {
 // Unified search handler with validation and action routing
 func unifiedSearchHandler(ctx context.Context, request mcp.CallToolRequest, args UnifiedSearchArgs) (*mcp.CallToolResult, error) {
 
-	client := util.GitlabClient()
+	client := util.GitlabClientFromContext(ctx)
 	
 	// Build search options
 	opt := &gitlab.SearchOptions{}
@@ -140,7 +140,7 @@ func unifiedSearchHandler(ctx context.Context, request mcp.CallToolRequest, args
 	if args.Options.PerPage > 0 {
 		opt.ListOptions.PerPage = args.Options.PerPage
 	} else {
-		opt.ListOptions.PerPage = 20 // default
+		opt.ListOptions.PerPage = util.DefaultPerPage(20)
 	}
 	
 	if args.Options.Page > 0 {
@@ -153,11 +153,11 @@ func unifiedSearchHandler(ctx context.Context, request mcp.CallToolRequest, args
 	// Route to appropriate search based on action
 	switch args.Action {
 	case "global":
-		result, err = performGlobalSearch(client, args, opt)
+		result, err = performGlobalSearch(ctx, client, args, opt)
 	case "group":
-		result, err = performGroupSearch(client, args, opt)
+		result, err = performGroupSearch(ctx, client, args, opt)
 	case "project":
-		result, err = performProjectSearch(client, args, opt)
+		result, err = performProjectSearch(ctx, client, args, opt)
 	default:
 		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: global, group, project", args.Action)), nil
 	}
@@ -174,122 +174,211 @@ func unifiedSearchHandler(ctx context.Context, request mcp.CallToolRequest, args
 }
 
 // Perform global search
-func performGlobalSearch(client *gitlab.Client, args UnifiedSearchArgs, opt *gitlab.SearchOptions) (string, error) {
+func performGlobalSearch(ctx context.Context, client *gitlab.Client, args UnifiedSearchArgs, opt *gitlab.SearchOptions) (string, error) {
 	switch args.Scope {
 	case "projects":
-		projects, _, err := client.Search.Projects(args.Query, opt)
+		projects, _, err := client.Search.Projects(args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return "", err
 		}
 		return formatProjectsResult(projects), nil
 
 	case "merge_requests":
-		mrs, _, err := client.Search.MergeRequests(args.Query, opt)
+		mrs, _, err := client.Search.MergeRequests(args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return "", err
 		}
-		return formatMergeRequestsResult(mrs), nil
+		return formatMergeRequestsResult(basicMergeRequests(mrs)), nil
 
 	case "commits":
-		commits, _, err := client.Search.Commits(args.Query, opt)
+		commits, _, err := client.Search.Commits(args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return "", err
 		}
 		return formatCommitsResult(commits), nil
 
 	case "blobs":
-		blobs, _, err := client.Search.Blobs(args.Query, opt)
+		blobs, _, err := client.Search.Blobs(args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return "", err
 		}
 		return formatBlobsResult(blobs), nil
 
 	case "users":
-		users, _, err := client.Search.Users(args.Query, opt)
+		users, _, err := client.Search.Users(args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return "", err
 		}
 		return formatUsersResult(users), nil
 
+	case "issues":
+		issues, _, err := client.Search.Issues(args.Query, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		return formatIssuesResult(issues), nil
+
+	case "milestones":
+		milestones, _, err := client.Search.Milestones(args.Query, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		return formatMilestonesResult(milestones), nil
+
+	case "snippets":
+		snippets, _, err := client.Search.SnippetTitles(args.Query, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		return formatSnippetsResult(snippets), nil
+
+	case "wikis":
+		wikis, _, err := client.Search.WikiBlobs(args.Query, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		return formatWikisResult(wikis), nil
+
+	case "notes":
+		return "", fmt.Errorf("notes scope is only available for project search")
+
 	default:
 		return "", fmt.Errorf("unsupported scope for global search: %s", args.Scope)
 	}
 }
 
 // Perform group search
-func performGroupSearch(client *gitlab.Client, args UnifiedSearchArgs, opt *gitlab.SearchOptions) (string, error) {
+func performGroupSearch(ctx context.Context, client *gitlab.Client, args UnifiedSearchArgs, opt *gitlab.SearchOptions) (string, error) {
 	switch args.Scope {
 	case "projects":
-		projects, _, err := client.Search.ProjectsByGroup(args.Context.GroupID, args.Query, opt)
+		projects, _, err := client.Search.ProjectsByGroup(args.Context.GroupID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return "", err
 		}
 		return formatProjectsResult(projects), nil
 
 	case "merge_requests":
-		mrs, _, err := client.Search.MergeRequestsByGroup(args.Context.GroupID, args.Query, opt)
+		mrs, _, err := client.Search.MergeRequestsByGroup(args.Context.GroupID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return "", err
 		}
-		return formatMergeRequestsResult(mrs), nil
+		return formatMergeRequestsResult(basicMergeRequests(mrs)), nil
 
 	case "commits":
-		commits, _, err := client.Search.CommitsByGroup(args.Context.GroupID, args.Query, opt)
+		commits, _, err := client.Search.CommitsByGroup(args.Context.GroupID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return "", err
 		}
 		return formatCommitsResult(commits), nil
 
 	case "blobs":
-		blobs, _, err := client.Search.BlobsByGroup(args.Context.GroupID, args.Query, opt)
+		blobs, _, err := client.Search.BlobsByGroup(args.Context.GroupID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return "", err
 		}
 		return formatBlobsResult(blobs), nil
 
 	case "users":
-		users, _, err := client.Search.UsersByGroup(args.Context.GroupID, args.Query, opt)
+		users, _, err := client.Search.UsersByGroup(args.Context.GroupID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return "", err
 		}
 		return formatUsersResult(users), nil
 
+	case "issues":
+		issues, _, err := client.Search.IssuesByGroup(args.Context.GroupID, args.Query, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		return formatIssuesResult(issues), nil
+
+	case "milestones":
+		milestones, _, err := client.Search.MilestonesByGroup(args.Context.GroupID, args.Query, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		return formatMilestonesResult(milestones), nil
+
+	case "wikis":
+		wikis, _, err := client.Search.WikiBlobsByGroup(args.Context.GroupID, args.Query, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		return formatWikisResult(wikis), nil
+
+	case "snippets":
+		return "", fmt.Errorf("snippets scope is only available for global search")
+
+	case "notes":
+		return "", fmt.Errorf("notes scope is only available for project search")
+
 	default:
 		return "", fmt.Errorf("unsupported scope for group search: %s", args.Scope)
 	}
 }
 
 // Perform project search
-func performProjectSearch(client *gitlab.Client, args UnifiedSearchArgs, opt *gitlab.SearchOptions) (string, error) {
+func performProjectSearch(ctx context.Context, client *gitlab.Client, args UnifiedSearchArgs, opt *gitlab.SearchOptions) (string, error) {
 	switch args.Scope {
 	case "merge_requests":
-		mrs, _, err := client.Search.MergeRequestsByProject(args.Context.ProjectID, args.Query, opt)
+		mrs, _, err := client.Search.MergeRequestsByProject(args.Context.ProjectID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return "", err
 		}
-		return formatMergeRequestsResult(mrs), nil
+		return formatMergeRequestsResult(basicMergeRequests(mrs)), nil
 
 	case "commits":
-		commits, _, err := client.Search.CommitsByProject(args.Context.ProjectID, args.Query, opt)
+		commits, _, err := client.Search.CommitsByProject(args.Context.ProjectID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return "", err
 		}
 		return formatCommitsResult(commits), nil
 
 	case "blobs":
-		blobs, _, err := client.Search.BlobsByProject(args.Context.ProjectID, args.Query, opt)
+		blobs, _, err := client.Search.BlobsByProject(args.Context.ProjectID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return "", err
 		}
 		return formatBlobsResult(blobs), nil
 
 	case "users":
-		users, _, err := client.Search.UsersByProject(args.Context.ProjectID, args.Query, opt)
+		users, _, err := client.Search.UsersByProject(args.Context.ProjectID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return "", err
 		}
 		return formatUsersResult(users), nil
 
+	case "issues":
+		issues, _, err := client.Search.IssuesByProject(args.Context.ProjectID, args.Query, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		return formatIssuesResult(issues), nil
+
+	case "milestones":
+		milestones, _, err := client.Search.MilestonesByProject(args.Context.ProjectID, args.Query, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		return formatMilestonesResult(milestones), nil
+
+	case "wikis":
+		wikis, _, err := client.Search.WikiBlobsByProject(args.Context.ProjectID, args.Query, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		return formatWikisResult(wikis), nil
+
+	case "notes":
+		notes, _, err := client.Search.NotesByProject(args.Context.ProjectID, args.Query, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		return formatNotesResult(notes), nil
+
+	case "snippets":
+		return "", fmt.Errorf("snippets scope is only available for global search")
+
 	default:
 		return "", fmt.Errorf("unsupported scope for project search: %s", args.Scope)
 	}
@@ -297,7 +386,7 @@ func performProjectSearch(client *gitlab.Client, args UnifiedSearchArgs, opt *gi
 
 // Global search handler
 func globalSearchHandler(ctx context.Context, request mcp.CallToolRequest, args GlobalSearchArgs) (*mcp.CallToolResult, error) {
-	client := util.GitlabClient()
+	client := util.GitlabClientFromContext(ctx)
 	
 	opt := &gitlab.SearchOptions{}
 	if args.Ref != "" {
@@ -308,35 +397,35 @@ func globalSearchHandler(ctx context.Context, request mcp.CallToolRequest, args
 
 	switch args.Scope {
 	case "projects":
-		projects, _, err := client.Search.Projects(args.Query, opt)
+		projects, _, err := client.Search.Projects(args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to search projects: %v", err)), nil
 		}
 		result = formatProjectsResult(projects)
 
 	case "merge_requests":
-		mrs, _, err := client.Search.MergeRequests(args.Query, opt)
+		mrs, _, err := client.Search.MergeRequests(args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to search merge requests: %v", err)), nil
 		}
-		result = formatMergeRequestsResult(mrs)
+		result = formatMergeRequestsResult(basicMergeRequests(mrs))
 
 	case "commits":
-		commits, _, err := client.Search.Commits(args.Query, opt)
+		commits, _, err := client.Search.Commits(args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to search commits: %v", err)), nil
 		}
 		result = formatCommitsResult(commits)
 
 	case "blobs":
-		blobs, _, err := client.Search.Blobs(args.Query, opt)
+		blobs, _, err := client.Search.Blobs(args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to search blobs: %v", err)), nil
 		}
 		result = formatBlobsResult(blobs)
 
 	case "users":
-		users, _, err := client.Search.Users(args.Query, opt)
+		users, _, err := client.Search.Users(args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to search users: %v", err)), nil
 		}
@@ -355,7 +444,7 @@ func globalSearchHandler(ctx context.Context, request mcp.CallToolRequest, args
 
 // Group search handler
 func groupSearchHandler(ctx context.Context, request mcp.CallToolRequest, args GroupSearchArgs) (*mcp.CallToolResult, error) {
-	client := util.GitlabClient()
+	client := util.GitlabClientFromContext(ctx)
 	
 	opt := &gitlab.SearchOptions{}
 	if args.Ref != "" {
@@ -366,35 +455,35 @@ func groupSearchHandler(ctx context.Context, request mcp.CallToolRequest, args G
 
 	switch args.Scope {
 	case "blobs":
-		blobs, _, err := client.Search.BlobsByGroup(args.GroupID, args.Query, opt)
+		blobs, _, err := client.Search.BlobsByGroup(args.GroupID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to search blobs in group: %v", err)), nil
 		}
 		result = formatBlobsResult(blobs)
 
 	case "projects":
-		projects, _, err := client.Search.ProjectsByGroup(args.GroupID, args.Query, opt)
+		projects, _, err := client.Search.ProjectsByGroup(args.GroupID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to search projects in group: %v", err)), nil
 		}
 		result = formatProjectsResult(projects)
 
 	case "merge_requests":
-		mrs, _, err := client.Search.MergeRequestsByGroup(args.GroupID, args.Query, opt)
+		mrs, _, err := client.Search.MergeRequestsByGroup(args.GroupID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to search merge requests in group: %v", err)), nil
 		}
-		result = formatMergeRequestsResult(mrs)
+		result = formatMergeRequestsResult(basicMergeRequests(mrs))
 
 	case "commits":
-		commits, _, err := client.Search.CommitsByGroup(args.GroupID, args.Query, opt)
+		commits, _, err := client.Search.CommitsByGroup(args.GroupID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to search commits in group: %v", err)), nil
 		}
 		result = formatCommitsResult(commits)
 
 	case "users":
-		users, _, err := client.Search.UsersByGroup(args.GroupID, args.Query, opt)
+		users, _, err := client.Search.UsersByGroup(args.GroupID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to search users in group: %v", err)), nil
 		}
@@ -413,7 +502,7 @@ func groupSearchHandler(ctx context.Context, request mcp.CallToolRequest, args G
 
 // Project search handler
 func projectSearchHandler(ctx context.Context, request mcp.CallToolRequest, args ProjectSearchArgs) (*mcp.CallToolResult, error) {
-	client := util.GitlabClient()
+	client := util.GitlabClientFromContext(ctx)
 	
 	opt := &gitlab.SearchOptions{}
 	if args.Ref != "" {
@@ -424,28 +513,28 @@ func projectSearchHandler(ctx context.Context, request mcp.CallToolRequest, args
 
 	switch args.Scope {
 	case "blobs":
-		blobs, _, err := client.Search.BlobsByProject(args.ProjectID, args.Query, opt)
+		blobs, _, err := client.Search.BlobsByProject(args.ProjectID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to search blobs in project: %v", err)), nil
 		}
 		result = formatBlobsResult(blobs)
 
 	case "merge_requests":
-		mrs, _, err := client.Search.MergeRequestsByProject(args.ProjectID, args.Query, opt)
+		mrs, _, err := client.Search.MergeRequestsByProject(args.ProjectID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to search merge requests in project: %v", err)), nil
 		}
-		result = formatMergeRequestsResult(mrs)
+		result = formatMergeRequestsResult(basicMergeRequests(mrs))
 
 	case "commits":
-		commits, _, err := client.Search.CommitsByProject(args.ProjectID, args.Query, opt)
+		commits, _, err := client.Search.CommitsByProject(args.ProjectID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to search commits in project: %v", err)), nil
 		}
 		result = formatCommitsResult(commits)
 
 	case "users":
-		users, _, err := client.Search.UsersByProject(args.ProjectID, args.Query, opt)
+		users, _, err := client.Search.UsersByProject(args.ProjectID, args.Query, opt, gitlab.WithContext(ctx))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to search users in project: %v", err)), nil
 		}
@@ -532,7 +621,16 @@ func formatIssuesResult(issues []*gitlab.Issue) string {
 	return result.String()
 }
 
-func formatMergeRequestsResult(mrs []*gitlab.MergeRequest) string {
+// basicMergeRequests converts search results to the form the list endpoints return
+func basicMergeRequests(mrs []*gitlab.MergeRequest) []*gitlab.BasicMergeRequest {
+	basic := make([]*gitlab.BasicMergeRequest, 0, len(mrs))
+	for _, mr := range mrs {
+		basic = append(basic, &mr.BasicMergeRequest)
+	}
+	return basic
+}
+
+func formatMergeRequestsResult(mrs []*gitlab.BasicMergeRequest) string {
 	if len(mrs) == 0 {
 		return ""
 	}
@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// issueSearchHandler answers an issues-scoped search for "crash" with one issue
+func issueSearchHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("scope") != "issues" || query.Get("search") != "crash" {
+			t.Errorf("unexpected search parameters: %s", r.URL.RawQuery)
+		}
+		writeJSON(t, w, []map[string]any{
+			{
+				"id":         1042,
+				"iid":        42,
+				"project_id": 7,
+				"title":      "App crashes on start",
+				"state":      "opened",
+				"author":     map[string]any{"name": "Alice"},
+				"created_at": "2025-01-01T10:00:00Z",
+				"web_url":    "https://gitlab.example.com/group/project/-/issues/42",
+			},
+		})
+	}
+}
+
+func TestUnifiedSearchIssues(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		action  string
+		project string
+	}{
+		{name: "global", path: "/api/v4/search", action: "global"},
+		{name: "project", path: "/api/v4/projects/group%2Fproject/-/search", action: "project", project: "group/project"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc(tt.path, issueSearchHandler(t))
+
+			args := UnifiedSearchArgs{Action: tt.action, Query: "crash", Scope: "issues"}
+			args.Context.ProjectID = tt.project
+
+			result, err := unifiedSearchHandler(newTestContext(t, mux), mcp.CallToolRequest{}, args)
+			text := resultText(t, result, err)
+
+			for _, want := range []string{"Found 1 issue(s)", "#42: App crashes on start", "State: opened"} {
+				if !strings.Contains(text, want) {
+					t.Errorf("result missing %q:\n%s", want, text)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/gitlab-mcp/util"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Consolidated tag management arguments with action-based routing
+type TagManagementArgs struct {
+	Action      string `json:"action" validate:"required,oneof=list get create delete"`
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=255"`
+	TagName     string `json:"tag_name,omitempty" validate:"omitempty,min=1,max=255"`
+	Confirmed   bool   `json:"confirmed,omitempty"`
+
+	// List action options
+	ListOptions struct {
+		Search string `json:"search,omitempty" validate:"omitempty,min=1,max=200"`
+	} `json:"list_options,omitempty"`
+
+	// Create action options
+	CreateOptions struct {
+		Ref                string `json:"ref,omitempty" validate:"omitempty,min=1,max=255"`
+		Message            string `json:"message,omitempty"`
+		ReleaseDescription string `json:"release_description,omitempty"`
+	} `json:"create_options,omitempty"`
+}
+
+func RegisterTagTools(s *server.MCPServer) {
+	tagManagementTool := mcp.NewTool("manage_tags",
+		mcp.WithDescription("Manage repository tags: list, get, create, delete"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: list, get, create, delete")),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
+		mcp.WithString("tag_name", mcp.Description("Tag name (required for: get, create, delete)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for create and delete actions")),
+
+		// List options
+		mcp.WithObject("list_options",
+			mcp.Description("Options for list action"),
+			mcp.Properties(map[string]any{
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Filter tags by name; use ^term or term$ to match the start or end",
+				},
+			}),
+		),
+
+		// Create options
+		mcp.WithObject("create_options",
+			mcp.Description("Options for create action"),
+			mcp.Properties(map[string]any{
+				"ref": map[string]any{
+					"type":        "string",
+					"description": "Branch name or commit SHA to tag (required for create)",
+				},
+				"message": map[string]any{
+					"type":        "string",
+					"description": "Tag message; creates an annotated tag instead of a lightweight one",
+				},
+				"release_description": map[string]any{
+					"type":        "string",
+					"description": "Release notes; creates a release for the new tag",
+				},
+			}),
+		),
+	)
+
+	s.AddTool(tagManagementTool, mcp.NewTypedToolHandler(tagManagementHandler))
+}
+
+// Consolidated tag management handler
+func tagManagementHandler(ctx context.Context, request mcp.CallToolRequest, args TagManagementArgs) (*mcp.CallToolResult, error) {
+	switch args.Action {
+	case "list":
+		return handleListTags(ctx, args)
+	case "get":
+		if args.TagName == "" {
+			return mcp.NewToolResultError("tag_name is required for get action"), nil
+		}
+		return handleGetTag(ctx, args)
+	case "create":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating the tag."), nil
+		}
+		if args.TagName == "" {
+			return mcp.NewToolResultError("tag_name is required for create action"), nil
+		}
+		if args.CreateOptions.Ref == "" {
+			return mcp.NewToolResultError("ref is required for create action"), nil
+		}
+		return handleCreateTag(ctx, args)
+	case "delete":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with deleting the tag."), nil
+		}
+		if args.TagName == "" {
+			return mcp.NewToolResultError("tag_name is required for delete action"), nil
+		}
+		return handleDeleteTag(ctx, args)
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list, get, create, delete", args.Action)), nil
+	}
+}
+
+func handleListTags(ctx context.Context, args TagManagementArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.ListTagsOptions{
+		OrderBy: gitlab.Ptr("updated"),
+		Sort:    gitlab.Ptr("desc"),
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+	if args.ListOptions.Search != "" {
+		opt.Search = gitlab.Ptr(args.ListOptions.Search)
+	}
+
+	tags, _, err := util.GitlabClientFromContext(ctx).Tags.ListTags(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list tags: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Tags for project %s:\n\n", args.ProjectPath))
+
+	if len(tags) == 0 {
+		result.WriteString("No tags found.\n")
+		return mcp.NewToolResultText(result.String()), nil
+	}
+
+	for _, tag := range tags {
+		result.WriteString(formatTagInfo(tag))
+		result.WriteString("\n")
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func handleGetTag(ctx context.Context, args TagManagementArgs) (*mcp.CallToolResult, error) {
+	tag, _, err := util.GitlabClientFromContext(ctx).Tags.GetTag(args.ProjectPath, args.TagName, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get tag: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(formatTagInfo(tag)), nil
+}
+
+func handleCreateTag(ctx context.Context, args TagManagementArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.CreateTagOptions{
+		TagName: gitlab.Ptr(args.TagName),
+		Ref:     gitlab.Ptr(args.CreateOptions.Ref),
+	}
+	if args.CreateOptions.Message != "" {
+		opt.Message = gitlab.Ptr(args.CreateOptions.Message)
+	}
+
+	tag, _, err := util.GitlabClientFromContext(ctx).Tags.CreateTag(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to create tag: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("✅ Created tag %s from %s\n\n", tag.Name, args.CreateOptions.Ref))
+	result.WriteString(formatTagInfo(tag))
+
+	// The tags API no longer accepts release notes, so create the release separately
+	if args.CreateOptions.ReleaseDescription != "" {
+		release, _, err := util.GitlabClientFromContext(ctx).Releases.CreateRelease(args.ProjectPath, &gitlab.CreateReleaseOptions{
+			TagName:     gitlab.Ptr(tag.Name),
+			Description: gitlab.Ptr(args.CreateOptions.ReleaseDescription),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			result.WriteString(fmt.Sprintf("\n⚠️ Tag created but failed to create release: %v\n", err))
+		} else {
+			result.WriteString(fmt.Sprintf("\nRelease: %s\n", release.Name))
+		}
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func handleDeleteTag(ctx context.Context, args TagManagementArgs) (*mcp.CallToolResult, error) {
+	_, err := util.GitlabClientFromContext(ctx).Tags.DeleteTag(args.ProjectPath, args.TagName, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to delete tag: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Successfully deleted tag '%s' in project %s\n", args.TagName, args.ProjectPath)), nil
+}
+
+// Helper function to format tag information
+func formatTagInfo(tag *gitlab.Tag) string {
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Tag: %s\n", tag.Name))
+	result.WriteString(fmt.Sprintf("Target: %s\n", tag.Target))
+	if tag.Commit != nil {
+		result.WriteString(fmt.Sprintf("Commit: %s\n", tag.Commit.ID))
+		result.WriteString(fmt.Sprintf("Commit Title: %s\n", tag.Commit.Title))
+		if tag.Commit.CommittedDate != nil {
+			result.WriteString(fmt.Sprintf("Committed: %s\n", tag.Commit.CommittedDate.Format("2006-01-02 15:04:05")))
+		}
+	}
+	if tag.Message != "" {
+		result.WriteString(fmt.Sprintf("Message: %s\n", tag.Message))
+	}
+	result.WriteString(fmt.Sprintf("Protected: %v\n", tag.Protected))
+	if tag.Release != nil && tag.Release.Description != "" {
+		result.WriteString(fmt.Sprintf("Release Notes: %s\n", tag.Release.Description))
+	}
+	return result.String()
+}
@@ -0,0 +1,125 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/gitlab-mcp/util"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Consolidated time tracking arguments with action-based routing
+type TimeTrackingArgs struct {
+	Action       string `json:"action" validate:"required,oneof=get set_estimate reset_estimate add_spent reset_spent"`
+	ProjectPath  string `json:"project_path" validate:"required,min=1,max=255"`
+	ResourceType string `json:"resource_type" validate:"required,oneof=issue merge_request"`
+	IID          int    `json:"iid" validate:"required,min=1"`
+	Duration     string `json:"duration,omitempty" validate:"omitempty,min=1,max=50"`
+	Summary      string `json:"summary,omitempty" validate:"omitempty,max=255"`
+	Confirmed    bool   `json:"confirmed,omitempty"`
+}
+
+// Matches GitLab's human durations such as "3h30m", "1w 2d" or "-30m"
+var durationPattern = regexp.MustCompile(`^-?(\d+\s*(mo|w|d|h|m|s)\s*)+$`)
+
+// timeTracker holds the time tracking endpoints that issues and merge requests share
+type timeTracker interface {
+	GetTimeSpent(pid any, iid int, options ...gitlab.RequestOptionFunc) (*gitlab.TimeStats, *gitlab.Response, error)
+	SetTimeEstimate(pid any, iid int, opt *gitlab.SetTimeEstimateOptions, options ...gitlab.RequestOptionFunc) (*gitlab.TimeStats, *gitlab.Response, error)
+	ResetTimeEstimate(pid any, iid int, options ...gitlab.RequestOptionFunc) (*gitlab.TimeStats, *gitlab.Response, error)
+	AddSpentTime(pid any, iid int, opt *gitlab.AddSpentTimeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.TimeStats, *gitlab.Response, error)
+	ResetSpentTime(pid any, iid int, options ...gitlab.RequestOptionFunc) (*gitlab.TimeStats, *gitlab.Response, error)
+}
+
+func RegisterTimeTrackingTools(s *server.MCPServer) {
+	timeTrackingTool := mcp.NewTool("manage_time_tracking",
+		mcp.WithDescription("Read and log time tracking on issues and merge requests: get, set_estimate, reset_estimate, add_spent, reset_spent"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: get, set_estimate, reset_estimate, add_spent, reset_spent")),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
+		mcp.WithString("resource_type", mcp.Required(), mcp.Description("Whether iid refers to an issue or a merge request"), mcp.Enum("issue", "merge_request")),
+		mcp.WithNumber("iid", mcp.Required(), mcp.Description("Issue or merge request IID")),
+		mcp.WithString("duration", mcp.Description("Human duration such as '3h30m', '1w 2d' or '45m' (required for set_estimate and add_spent; prefix with '-' to subtract spent time)")),
+		mcp.WithString("summary", mcp.Description("Note describing the logged time (add_spent action)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for every action except get")),
+	)
+
+	s.AddTool(timeTrackingTool, mcp.NewTypedToolHandler(timeTrackingHandler))
+}
+
+func timeTrackingHandler(ctx context.Context, request mcp.CallToolRequest, args TimeTrackingArgs) (*mcp.CallToolResult, error) {
+	var tracker timeTracker = util.GitlabClientFromContext(ctx).Issues
+	if args.ResourceType == "merge_request" {
+		tracker = util.GitlabClientFromContext(ctx).MergeRequests
+	}
+	resource := resourceName(args.ResourceType, args.IID)
+
+	if args.Action != "get" && !args.Confirmed {
+		return mcp.NewToolResultError(fmt.Sprintf("This operation requires confirmation. Please set 'confirmed: true' to proceed with %s on %s.", args.Action, resource)), nil
+	}
+	duration := strings.TrimSpace(args.Duration)
+	if (args.Action == "set_estimate" || args.Action == "add_spent") && !durationPattern.MatchString(duration) {
+		return mcp.NewToolResultError(fmt.Sprintf("duration is required for %s action and must look like '3h30m', '1w 2d' or '45m'", args.Action)), nil
+	}
+	// Only spent time can be negative, to correct an earlier entry
+	if args.Action == "set_estimate" && strings.HasPrefix(duration, "-") {
+		return mcp.NewToolResultError("duration can't be negative for set_estimate action; use reset_estimate to clear it"), nil
+	}
+
+	var stats *gitlab.TimeStats
+	var err error
+	switch args.Action {
+	case "get":
+		stats, _, err = tracker.GetTimeSpent(args.ProjectPath, args.IID, gitlab.WithContext(ctx))
+	case "set_estimate":
+		stats, _, err = tracker.SetTimeEstimate(args.ProjectPath, args.IID, &gitlab.SetTimeEstimateOptions{
+			Duration: gitlab.Ptr(duration),
+		}, gitlab.WithContext(ctx))
+	case "reset_estimate":
+		stats, _, err = tracker.ResetTimeEstimate(args.ProjectPath, args.IID, gitlab.WithContext(ctx))
+	case "add_spent":
+		opt := &gitlab.AddSpentTimeOptions{
+			Duration: gitlab.Ptr(duration),
+		}
+		if args.Summary != "" {
+			opt.Summary = gitlab.Ptr(args.Summary)
+		}
+		stats, _, err = tracker.AddSpentTime(args.ProjectPath, args.IID, opt, gitlab.WithContext(ctx))
+	case "reset_spent":
+		stats, _, err = tracker.ResetSpentTime(args.ProjectPath, args.IID, gitlab.WithContext(ctx))
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("invalid action: %s. Valid actions are: get, set_estimate, reset_estimate, add_spent, reset_spent", args.Action)), nil
+	}
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to %s for %s: %v", strings.ReplaceAll(args.Action, "_", " "), resource, err)), nil
+	}
+
+	return mcp.NewToolResultText(formatTimeStats(resource, stats)), nil
+}
+
+func formatTimeStats(resource string, stats *gitlab.TimeStats) string {
+	estimate, spent := stats.HumanTimeEstimate, stats.HumanTotalTimeSpent
+	if estimate == "" {
+		estimate = "none"
+	}
+	if spent == "" {
+		spent = "none"
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Time tracking for %s:\n", resource))
+	result.WriteString(fmt.Sprintf("Estimate: %s\n", estimate))
+	result.WriteString(fmt.Sprintf("Spent: %s\n", spent))
+	if stats.TimeEstimate > 0 {
+		remaining := stats.TimeEstimate - stats.TotalTimeSpent
+		if remaining >= 0 {
+			result.WriteString(fmt.Sprintf("Remaining: %.1fh\n", float64(remaining)/3600))
+		} else {
+			result.WriteString(fmt.Sprintf("⚠️ Over estimate by %.1fh\n", float64(-remaining)/3600))
+		}
+	}
+	return result.String()
+}
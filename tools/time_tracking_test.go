@@ -0,0 +1,30 @@
+package tools
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSetEstimateRejectsNegativeDuration(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	result, err := timeTrackingHandler(newTestContext(t, mux), mcp.CallToolRequest{}, TimeTrackingArgs{
+		Action:       "set_estimate",
+		ProjectPath:  "group/project",
+		ResourceType: "issue",
+		IID:          3,
+		Duration:     "-2h",
+		Confirmed:    true,
+	})
+	if err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	if !result.IsError {
+		t.Fatalf("expected an error result for a negative estimate, got:\n%s", toolResultText(result))
+	}
+}
@@ -16,30 +16,104 @@ type ListUserEventsArgs struct {
 	Username string `json:"username"`
 	Since    string `json:"since"`
 	Until    string `json:"until"`
+	Timezone string `json:"timezone"`
+}
+
+type ListUserReviewRequestsArgs struct {
+	Username string `json:"username" validate:"required,min=1"`
+	State    string `json:"state" validate:"omitempty,oneof=opened closed merged all"`
+}
+
+type ListMyMergeRequestsArgs struct {
+	Scope string `json:"scope,omitempty" validate:"omitempty,oneof=created_by_me assigned_to_me"`
+	State string `json:"state,omitempty" validate:"omitempty,oneof=opened closed merged all"`
+	Draft *bool  `json:"draft,omitempty"`
+}
+
+type ListMyIssuesArgs struct {
+	Scope string `json:"scope,omitempty" validate:"omitempty,oneof=assigned_to_me created_by_me"`
+	State string `json:"state,omitempty" validate:"omitempty,oneof=opened closed all"`
+}
+
+type TodoManagementArgs struct {
+	Action     string `json:"action" validate:"required,oneof=list mark_done mark_all_done"`
+	TodoID     int    `json:"todo_id,omitempty" validate:"required_if=Action mark_done,omitempty,min=1"`
+	State      string `json:"state,omitempty" validate:"omitempty,oneof=pending done"`
+	Type       string `json:"type,omitempty" validate:"omitempty,oneof=Issue MergeRequest Commit Epic DesignManagement::Design AlertManagement::Alert"`
+	TodoAction string `json:"todo_action,omitempty" validate:"omitempty,oneof=assigned mentioned build_failed marked approval_required unmergeable directly_addressed merge_train_removed review_requested"`
+	Confirmed  bool   `json:"confirmed,omitempty"`
 }
 
 func RegisterUserTools(s *server.MCPServer) {
 	userEventsTool := mcp.NewTool("list_user_contribution_events",
 		mcp.WithDescription("List GitLab user contribution events within a date range"),
 		mcp.WithString("username", mcp.Required(), mcp.Description("GitLab username")),
-		mcp.WithString("since", mcp.Required(), mcp.Description("Start date (YYYY-MM-DD)")),
-		mcp.WithString("until", mcp.Description("End date (YYYY-MM-DD). If not provided, defaults to current date")),
+		mcp.WithString("since", mcp.Required(), mcp.Description("Start date (YYYY-MM-DD, or relative such as 7d, 2w, yesterday, last week)")),
+		mcp.WithString("until", mcp.Description("End date (YYYY-MM-DD or relative). If not provided, defaults to current date")),
+		mcp.WithString("timezone", mcp.Description("IANA timezone for the day boundaries of since/until, e.g. 'America/New_York' (default: UTC)")),
 	)
 	s.AddTool(userEventsTool, mcp.NewTypedToolHandler(listUserEventsHandler))
+
+	userReviewRequestsTool := mcp.NewTool("list_user_review_requests",
+		mcp.WithDescription("List merge requests across the instance where a user is requested as a reviewer"),
+		mcp.WithString("username", mcp.Required(), mcp.Description("GitLab username of the reviewer")),
+		mcp.WithString("state", mcp.Description("MR state (opened/closed/merged/all, default: opened)")),
+	)
+	s.AddTool(userReviewRequestsTool, mcp.NewTypedToolHandler(listUserReviewRequestsHandler))
+
+	myMergeRequestsTool := mcp.NewTool("list_my_merge_requests",
+		mcp.WithDescription("List the authenticated user's merge requests across all projects, either authored or assigned"),
+		mcp.WithString("scope", mcp.Description("created_by_me or assigned_to_me (default: created_by_me)"), mcp.Enum("created_by_me", "assigned_to_me")),
+		mcp.WithString("state", mcp.Description("MR state (opened/closed/merged/all, default: opened)")),
+		mcp.WithBoolean("draft", mcp.Description("Only drafts when true, only ready MRs when false (default: both)")),
+	)
+	s.AddTool(myMergeRequestsTool, mcp.NewTypedToolHandler(listMyMergeRequestsHandler))
+
+	myIssuesTool := mcp.NewTool("list_my_issues",
+		mcp.WithDescription("List issues assigned to (or created by) the authenticated user across all projects"),
+		mcp.WithString("scope", mcp.Description("assigned_to_me or created_by_me (default: assigned_to_me)"), mcp.Enum("assigned_to_me", "created_by_me")),
+		mcp.WithString("state", mcp.Description("Issue state (opened/closed/all, default: opened)")),
+	)
+	s.AddTool(myIssuesTool, mcp.NewTypedToolHandler(listMyIssuesHandler))
+
+	todoManagementTool := mcp.NewTool("manage_todos",
+		mcp.WithDescription("Manage the authenticated user's to-do list: list, mark_done, mark_all_done"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: list, mark_done, mark_all_done")),
+		mcp.WithNumber("todo_id", mcp.Description("To-do ID (required for mark_done)")),
+		mcp.WithString("state", mcp.Description("To-do state filter for list (pending/done, default: pending)")),
+		mcp.WithString("type", mcp.Description("Target type filter for list: Issue, MergeRequest, Commit, Epic, DesignManagement::Design, AlertManagement::Alert")),
+		mcp.WithString("todo_action", mcp.Description("Reason filter for list: assigned, mentioned, build_failed, marked, approval_required, unmergeable, directly_addressed, merge_train_removed, review_requested")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for mark_all_done action")),
+	)
+	s.AddTool(todoManagementTool, mcp.NewTypedToolHandler(todoManagementHandler))
 }
 
 func listUserEventsHandler(ctx context.Context, request mcp.CallToolRequest, args ListUserEventsArgs) (*mcp.CallToolResult, error) {
+	loc, err := util.LoadTimezone(args.Timezone)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	until := args.Until
 	if until == "" {
-		until = time.Now().Format("2006-01-02")
+		until = time.Now().In(loc).Format("2006-01-02")
 	}
 
-	sinceTime, err := time.Parse("2006-01-02", args.Since)
+	since, err := util.ResolveDate(args.Since, loc)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("invalid since date: %v", err)), nil
 	}
+	until, err = util.ResolveDate(until, loc)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid until date: %v", err)), nil
+	}
 
-	untilTime, err := time.Parse("2006-01-02 15:04:05", until+" 23:59:59")
+	sinceTime, err := time.ParseInLocation("2006-01-02", since, loc)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid since date: %v", err)), nil
+	}
+
+	untilTime, err := time.ParseInLocation("2006-01-02 15:04:05", until+" 23:59:59", loc)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("invalid until date: %v", err)), nil
 	}
@@ -48,18 +122,18 @@ func listUserEventsHandler(ctx context.Context, request mcp.CallToolRequest, arg
 		After:  gitlab.Ptr(gitlab.ISOTime(sinceTime)),
 		Before: gitlab.Ptr(gitlab.ISOTime(untilTime)),
 		ListOptions: gitlab.ListOptions{
-			PerPage: 100,
+			PerPage: util.DefaultPerPage(100),
 		},
 	}
 
-	events, _, err := util.GitlabClient().Users.ListUserContributionEvents(args.Username, opt)
+	events, _, err := util.GitlabClientFromContext(ctx).Users.ListUserContributionEvents(args.Username, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to list user events: %v", err)), nil
 	}
 
 	var result strings.Builder
 	result.WriteString(fmt.Sprintf("Events for user %s between %s and %s:\n\n",
-		args.Username, args.Since, until))
+		args.Username, since, until))
 
 	for _, event := range events {
 		result.WriteString(fmt.Sprintf("Date: %s\n", event.CreatedAt.Format("2006-01-02 15:04:05")))
@@ -89,4 +163,249 @@ func listUserEventsHandler(ctx context.Context, request mcp.CallToolRequest, arg
 	}
 
 	return mcp.NewToolResultText(result.String()), nil
-} 
\ No newline at end of file
+}
+
+func listUserReviewRequestsHandler(ctx context.Context, request mcp.CallToolRequest, args ListUserReviewRequestsArgs) (*mcp.CallToolResult, error) {
+	state := args.State
+	if state == "" {
+		state = "opened"
+	}
+
+	userID, err := resolveUserID(ctx, args.Username)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Scope "all" is needed, the instance-wide endpoint defaults to MRs created by the token owner
+	mrs, _, err := util.GitlabClientFromContext(ctx).MergeRequests.ListMergeRequests(&gitlab.ListMergeRequestsOptions{
+		ReviewerID: gitlab.ReviewerID(userID),
+		State:      gitlab.Ptr(state),
+		Scope:      gitlab.Ptr("all"),
+		OrderBy:    gitlab.Ptr("updated_at"),
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list review requests: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Merge requests (%s) with %s as reviewer: %d\n\n", state, args.Username, len(mrs)))
+	for _, mr := range mrs {
+		formatInstanceMergeRequest(&result, mr)
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func listMyMergeRequestsHandler(ctx context.Context, request mcp.CallToolRequest, args ListMyMergeRequestsArgs) (*mcp.CallToolResult, error) {
+	scope := args.Scope
+	if scope == "" {
+		scope = "created_by_me"
+	}
+	state := args.State
+	if state == "" {
+		state = "opened"
+	}
+
+	opt := &gitlab.ListMergeRequestsOptions{
+		Scope:   gitlab.Ptr(scope),
+		State:   gitlab.Ptr(state),
+		OrderBy: gitlab.Ptr("updated_at"),
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+	if args.Draft != nil {
+		opt.Draft = args.Draft
+	}
+
+	mrs, truncated, err := util.CollectPages(&opt.ListOptions, maxListedMergeRequests, func() ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).MergeRequests.ListMergeRequests(opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list merge requests: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Merge requests (%s) %s: %d\n\n", state, strings.ReplaceAll(scope, "_", " "), len(mrs)))
+	for _, mr := range mrs {
+		formatInstanceMergeRequest(&result, mr)
+	}
+	if truncated {
+		result.WriteString(fmt.Sprintf("⚠️ Showing the %d most recently updated merge requests, more match. Narrow the state or scope to see the rest.\n", len(mrs)))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func listMyIssuesHandler(ctx context.Context, request mcp.CallToolRequest, args ListMyIssuesArgs) (*mcp.CallToolResult, error) {
+	scope := args.Scope
+	if scope == "" {
+		scope = "assigned_to_me"
+	}
+	state := args.State
+	if state == "" {
+		state = "opened"
+	}
+
+	opt := &gitlab.ListIssuesOptions{
+		Scope:   gitlab.Ptr(scope),
+		OrderBy: gitlab.Ptr("updated_at"),
+		ListOptions: gitlab.ListOptions{
+			PerPage: util.DefaultPerPage(100),
+		},
+	}
+	// The API has no "all" state, leaving it unset returns both
+	if state != "all" {
+		opt.State = gitlab.Ptr(state)
+	}
+
+	issues, truncated, err := util.CollectPages(&opt.ListOptions, maxListedIssues, func() ([]*gitlab.Issue, *gitlab.Response, error) {
+		return util.GitlabClientFromContext(ctx).Issues.ListIssues(opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %v", err)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Issues (%s) %s: %d\n\n", state, strings.ReplaceAll(scope, "_", " "), len(issues)))
+	for _, issue := range issues {
+		reference := fmt.Sprintf("#%d", issue.IID)
+		if issue.References != nil {
+			reference = issue.References.Full
+		}
+		result.WriteString(fmt.Sprintf("%s: %s\n", reference, issue.Title))
+		result.WriteString(fmt.Sprintf("State: %s\n", issue.State))
+		if len(issue.Labels) > 0 {
+			result.WriteString(fmt.Sprintf("Labels: %s\n", strings.Join(issue.Labels, ", ")))
+		}
+		if issue.Milestone != nil {
+			result.WriteString(fmt.Sprintf("Milestone: %s\n", issue.Milestone.Title))
+		}
+		if issue.DueDate != nil {
+			result.WriteString(fmt.Sprintf("Due Date: %s\n", issue.DueDate.String()))
+		}
+		if issue.UpdatedAt != nil {
+			result.WriteString(fmt.Sprintf("Updated: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04:05")))
+		}
+		result.WriteString(fmt.Sprintf("URL: %s\n\n", issue.WebURL))
+	}
+	if truncated {
+		result.WriteString(fmt.Sprintf("⚠️ Showing the %d most recently updated issues, more match. Narrow the state or scope to see the rest.\n", len(issues)))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// formatInstanceMergeRequest writes an MR from an instance-wide list, where the
+// full reference is needed to tell projects apart
+func formatInstanceMergeRequest(result *strings.Builder, mr *gitlab.BasicMergeRequest) {
+	reference := fmt.Sprintf("!%d", mr.IID)
+	if mr.References != nil {
+		reference = mr.References.Full
+	}
+	result.WriteString(fmt.Sprintf("%s: %s\n", reference, mr.Title))
+	if mr.Author != nil {
+		result.WriteString(fmt.Sprintf("Author: %s\n", mr.Author.Username))
+	}
+	result.WriteString(fmt.Sprintf("State: %s\n", mr.State))
+	if mr.Draft {
+		result.WriteString("Draft: yes\n")
+	}
+	if mr.UpdatedAt != nil {
+		result.WriteString(fmt.Sprintf("Updated: %s\n", mr.UpdatedAt.Format("2006-01-02 15:04:05")))
+	}
+	result.WriteString(fmt.Sprintf("URL: %s\n\n", mr.WebURL))
+}
+
+// resolveUserID looks up a user by username and returns its ID
+func resolveUserID(ctx context.Context, username string) (int, error) {
+	users, _, err := util.GitlabClientFromContext(ctx).Users.ListUsers(&gitlab.ListUsersOptions{
+		Username: gitlab.Ptr(username),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to look up user: %v", err)
+	}
+	if len(users) == 0 {
+		return 0, fmt.Errorf("user %s not found", username)
+	}
+	return users[0].ID, nil
+}
+
+func todoManagementHandler(ctx context.Context, request mcp.CallToolRequest, args TodoManagementArgs) (*mcp.CallToolResult, error) {
+	switch args.Action {
+	case "list":
+		return listTodos(ctx, args)
+	case "mark_done":
+		if args.TodoID == 0 {
+			return mcp.NewToolResultError("todo_id is required for mark_done action"), nil
+		}
+		_, err := util.GitlabClientFromContext(ctx).Todos.MarkTodoAsDone(args.TodoID, gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to mark to-do as done: %v", err)), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("✅ To-do %d marked as done", args.TodoID)), nil
+	case "mark_all_done":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with marking all pending to-dos as done."), nil
+		}
+		_, err := util.GitlabClientFromContext(ctx).Todos.MarkAllTodosAsDone(gitlab.WithContext(ctx))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to mark all to-dos as done: %v", err)), nil
+		}
+		return mcp.NewToolResultText("✅ All pending to-dos marked as done"), nil
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list, mark_done, mark_all_done", args.Action)), nil
+	}
+}
+
+func listTodos(ctx context.Context, args TodoManagementArgs) (*mcp.CallToolResult, error) {
+	state := args.State
+	if state == "" {
+		state = "pending"
+	}
+
+	opt := &gitlab.ListTodosOptions{
+		ListOptions: gitlab.ListOptions{PerPage: util.DefaultPerPage(100)},
+		State:       gitlab.Ptr(state),
+	}
+	if args.Type != "" {
+		opt.Type = gitlab.Ptr(args.Type)
+	}
+	if args.TodoAction != "" {
+		opt.Action = gitlab.Ptr(gitlab.TodoAction(args.TodoAction))
+	}
+
+	todos, _, err := util.GitlabClientFromContext(ctx).Todos.ListTodos(opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list to-dos: %v", err)), nil
+	}
+
+	if len(todos) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No %s to-dos found", state)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("To-dos (%s, %d):\n\n", state, len(todos)))
+	for _, todo := range todos {
+		result.WriteString(fmt.Sprintf("ID: %d\n", todo.ID))
+		result.WriteString(fmt.Sprintf("Reason: %s\n", todo.ActionName))
+		if todo.Target != nil {
+			result.WriteString(fmt.Sprintf("%s: %s\n", todo.TargetType, todo.Target.Title))
+		}
+		if todo.Project != nil {
+			result.WriteString(fmt.Sprintf("Project: %s\n", todo.Project.PathWithNamespace))
+		}
+		if todo.Author != nil {
+			result.WriteString(fmt.Sprintf("From: %s\n", todo.Author.Username))
+		}
+		if todo.CreatedAt != nil {
+			result.WriteString(fmt.Sprintf("Created: %s\n", todo.CreatedAt.Format("2006-01-02 15:04:05")))
+		}
+		result.WriteString(fmt.Sprintf("URL: %s\n\n", todo.TargetURL))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
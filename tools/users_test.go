@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestListMyMergeRequestsPagesUpToTheCap(t *testing.T) {
+	var pages atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		pages.Add(1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		page = max(page, 1)
+
+		var mrs []map[string]any
+		for i := range 100 {
+			iid := (page-1)*100 + i + 1
+			mrs = append(mrs, map[string]any{"iid": iid, "title": fmt.Sprintf("MR %d", iid), "author": map[string]any{"username": "me"}, "created_at": "2025-01-01T10:00:00Z"})
+		}
+		w.Header().Set("X-Next-Page", strconv.Itoa(page+1))
+		writeJSON(t, w, mrs)
+	})
+
+	result, err := listMyMergeRequestsHandler(newTestContext(t, mux), mcp.CallToolRequest{}, ListMyMergeRequestsArgs{})
+	text := resultText(t, result, err)
+
+	if want := int32(maxListedMergeRequests / 100); pages.Load() != want {
+		t.Errorf("fetched %d pages, want %d", pages.Load(), want)
+	}
+	if !strings.Contains(text, "!150: MR 150") || !strings.Contains(text, "⚠️") {
+		t.Errorf("later pages or the truncation note missing:\n%s", text)
+	}
+}
+
+func TestListMyIssuesPagesUpToTheCap(t *testing.T) {
+	var pages atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/issues", func(w http.ResponseWriter, r *http.Request) {
+		pages.Add(1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		page = max(page, 1)
+
+		var issues []map[string]any
+		for i := range 100 {
+			iid := (page-1)*100 + i + 1
+			issues = append(issues, map[string]any{"id": iid, "iid": iid, "title": fmt.Sprintf("Issue %d", iid)})
+		}
+		w.Header().Set("X-Next-Page", strconv.Itoa(page+1))
+		writeJSON(t, w, issues)
+	})
+
+	result, err := listMyIssuesHandler(newTestContext(t, mux), mcp.CallToolRequest{}, ListMyIssuesArgs{})
+	text := resultText(t, result, err)
+
+	if want := int32(maxListedIssues / 100); pages.Load() != want {
+		t.Errorf("fetched %d pages, want %d", pages.Load(), want)
+	}
+	if !strings.Contains(text, "#150: Issue 150") || !strings.Contains(text, "⚠️") {
+		t.Errorf("later pages or the truncation note missing:\n%s", text)
+	}
+}
+
+func TestListMyMergeRequestsToleratesMissingAuthor(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, []map[string]any{{"iid": 1, "title": "Orphaned MR", "author": nil}})
+	})
+
+	result, err := listMyMergeRequestsHandler(newTestContext(t, mux), mcp.CallToolRequest{}, ListMyMergeRequestsArgs{})
+	text := resultText(t, result, err)
+
+	if !strings.Contains(text, "!1: Orphaned MR") {
+		t.Errorf("result missing the MR:\n%s", text)
+	}
+}
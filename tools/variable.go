@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -16,12 +17,14 @@ type GroupVariableArgs struct {
 	Action            string            `json:"action" validate:"required,oneof=list get create update remove"`
 	GroupID           string            `json:"group_id" validate:"required"`
 	Key               string            `json:"key" validate:"required_unless=Action list"`
-	Value             string            `json:"value" validate:"required_if=Action create"`
+	Value             string            `json:"value"`
 	VariableType      string            `json:"variable_type" validate:"omitempty,oneof=env_var file"`
 	Protected         *bool             `json:"protected"`
 	Masked            *bool             `json:"masked"`
 	Raw               *bool             `json:"raw"`
 	EnvironmentScope  string            `json:"environment_scope"`
+	EnvironmentScopes []string          `json:"environment_scopes"`
+	ScopeValues       map[string]string `json:"scope_values"`
 	Description       string            `json:"description"`
 	Confirmed         bool              `json:"confirmed,omitempty"`
 }
@@ -31,19 +34,21 @@ type ProjectVariableArgs struct {
 	Action            string            `json:"action" validate:"required,oneof=list get create update remove"`
 	ProjectID         string            `json:"project_id" validate:"required"`
 	Key               string            `json:"key" validate:"required_unless=Action list"`
-	Value             string            `json:"value" validate:"required_if=Action create"`
+	Value             string            `json:"value"`
 	VariableType      string            `json:"variable_type" validate:"omitempty,oneof=env_var file"`
 	Protected         *bool             `json:"protected"`
 	Masked            *bool             `json:"masked"`
 	Raw               *bool             `json:"raw"`
 	EnvironmentScope  string            `json:"environment_scope"`
+	EnvironmentScopes []string          `json:"environment_scopes"`
+	ScopeValues       map[string]string `json:"scope_values"`
 	Description       string            `json:"description"`
 	Confirmed         bool              `json:"confirmed,omitempty"`
 }
 
 // getAncestorGroups returns all ancestor groups of a project, starting from immediate parent
-func getAncestorGroups(projectID string) ([]*gitlab.Group, error) {
-	project, _, err := util.GitlabClient().Projects.GetProject(projectID, nil)
+func getAncestorGroups(ctx context.Context, projectID string) ([]*gitlab.Group, error) {
+	project, _, err := util.GitlabClientFromContext(ctx).Projects.GetProject(projectID, nil, gitlab.WithContext(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get project: %v", err)
 	}
@@ -52,7 +57,7 @@ func getAncestorGroups(projectID string) ([]*gitlab.Group, error) {
 	
 	if project.Namespace != nil && project.Namespace.Kind == "group" {
 		// Get the immediate parent group
-		group, _, err := util.GitlabClient().Groups.GetGroup(project.Namespace.ID, nil)
+		group, _, err := util.GitlabClientFromContext(ctx).Groups.GetGroup(project.Namespace.ID, nil, gitlab.WithContext(ctx))
 		if err != nil {
 			return nil, fmt.Errorf("failed to get group: %v", err)
 		}
@@ -61,7 +66,7 @@ func getAncestorGroups(projectID string) ([]*gitlab.Group, error) {
 		// Get all ancestor groups
 		currentGroup := group
 		for currentGroup.ParentID != 0 {
-			parentGroup, _, err := util.GitlabClient().Groups.GetGroup(currentGroup.ParentID, nil)
+			parentGroup, _, err := util.GitlabClientFromContext(ctx).Groups.GetGroup(currentGroup.ParentID, nil, gitlab.WithContext(ctx))
 			if err != nil {
 				break // Stop if we can't fetch the parent
 			}
@@ -86,7 +91,7 @@ func RegisterVariableTools(s *server.MCPServer) {
 		mcp.WithString("key", 
 			mcp.Description("Variable key name (required for get, create, update, remove actions)")),
 		mcp.WithString("value", 
-			mcp.Description("Variable value (required for create action, optional for update)")),
+			mcp.Description("Variable value (required for create action unless scope_values covers every environment scope, optional for update)")),
 		mcp.WithString("variable_type", 
 			mcp.Description("Variable type: env_var (default) or file")),
 		mcp.WithBoolean("protected", 
@@ -97,6 +102,11 @@ func RegisterVariableTools(s *server.MCPServer) {
 			mcp.Description("Whether the variable is raw")),
 		mcp.WithString("environment_scope", 
 			mcp.Description("Environment scope (default: *)")),
+		mcp.WithArray("environment_scopes",
+			mcp.Description("Create the same key in each of these environment scopes in one call (create action only, overrides environment_scope)"),
+			mcp.Items(map[string]any{"type": "string"})),
+		mcp.WithObject("scope_values",
+			mcp.Description("Per-scope values for environment_scopes, keyed by environment scope; scopes not listed use value")),
 		mcp.WithString("description", 
 			mcp.Description("Variable description")),
 		mcp.WithBoolean("confirmed", 
@@ -116,7 +126,7 @@ func RegisterVariableTools(s *server.MCPServer) {
 		mcp.WithString("key", 
 			mcp.Description("Variable key name (required for get, create, update, remove actions)")),
 		mcp.WithString("value", 
-			mcp.Description("Variable value (required for create action, optional for update)")),
+			mcp.Description("Variable value (required for create action unless scope_values covers every environment scope, optional for update)")),
 		mcp.WithString("variable_type", 
 			mcp.Description("Variable type: env_var (default) or file")),
 		mcp.WithBoolean("protected", 
@@ -127,6 +137,11 @@ func RegisterVariableTools(s *server.MCPServer) {
 			mcp.Description("Whether the variable is raw")),
 		mcp.WithString("environment_scope", 
 			mcp.Description("Environment scope (default: *)")),
+		mcp.WithArray("environment_scopes",
+			mcp.Description("Create the same key in each of these environment scopes in one call (create action only, overrides environment_scope)"),
+			mcp.Items(map[string]any{"type": "string"})),
+		mcp.WithObject("scope_values",
+			mcp.Description("Per-scope values for environment_scopes, keyed by environment scope; scopes not listed use value")),
 		mcp.WithString("description", 
 			mcp.Description("Variable description")),
 		mcp.WithBoolean("confirmed", 
@@ -138,33 +153,33 @@ func RegisterVariableTools(s *server.MCPServer) {
 func groupVariableHandler(ctx context.Context, request mcp.CallToolRequest, args GroupVariableArgs) (*mcp.CallToolResult, error) {
 	switch args.Action {
 	case "list":
-		return listGroupVariables(args)
+		return listGroupVariables(ctx, args)
 	case "get":
-		return getGroupVariable(args)
+		return getGroupVariable(ctx, args)
 	case "create":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating a group variable."), nil
 		}
-		return createGroupVariable(args)
+		return createGroupVariable(ctx, args)
 	case "update":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with updating a group variable."), nil
 		}
-		return updateGroupVariable(args)
+		return updateGroupVariable(ctx, args)
 	case "remove":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with removing a group variable."), nil
 		}
-		return removeGroupVariable(args)
+		return removeGroupVariable(ctx, args)
 	default:
 		return mcp.NewToolResultError(fmt.Sprintf("invalid action: %s. Valid actions are: list, get, create, update, remove", args.Action)), nil
 	}
 }
 
-func listGroupVariables(args GroupVariableArgs) (*mcp.CallToolResult, error) {
+func listGroupVariables(ctx context.Context, args GroupVariableArgs) (*mcp.CallToolResult, error) {
 	opt := &gitlab.ListGroupVariablesOptions{}
 
-	variables, _, err := util.GitlabClient().GroupVariables.ListVariables(args.GroupID, opt)
+	variables, _, err := util.GitlabClientFromContext(ctx).GroupVariables.ListVariables(args.GroupID, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to list group variables: %v", err)), nil
 	}
@@ -202,12 +217,12 @@ func listGroupVariables(args GroupVariableArgs) (*mcp.CallToolResult, error) {
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func getGroupVariable(args GroupVariableArgs) (*mcp.CallToolResult, error) {
+func getGroupVariable(ctx context.Context, args GroupVariableArgs) (*mcp.CallToolResult, error) {
 	if args.Key == "" {
 		return mcp.NewToolResultError("key is required for get action"), nil
 	}
 
-	variable, _, err := util.GitlabClient().GroupVariables.GetVariable(args.GroupID, args.Key, nil)
+	variable, _, err := util.GitlabClientFromContext(ctx).GroupVariables.GetVariable(args.GroupID, args.Key, nil, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get group variable: %v", err)), nil
 	}
@@ -235,17 +250,19 @@ func getGroupVariable(args GroupVariableArgs) (*mcp.CallToolResult, error) {
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func createGroupVariable(args GroupVariableArgs) (*mcp.CallToolResult, error) {
+func createGroupVariable(ctx context.Context, args GroupVariableArgs) (*mcp.CallToolResult, error) {
 	if args.Key == "" {
 		return mcp.NewToolResultError("key is required for create action"), nil
 	}
-	if args.Value == "" {
-		return mcp.NewToolResultError("value is required for create action"), nil
+	if args.Value == "" && !coversEveryScope(args.EnvironmentScopes, args.ScopeValues) {
+		return mcp.NewToolResultError("value is required for create action, unless scope_values sets every scope in environment_scopes"), nil
 	}
 
 	opt := &gitlab.CreateGroupVariableOptions{
-		Key:   gitlab.Ptr(args.Key),
-		Value: gitlab.Ptr(args.Value),
+		Key: gitlab.Ptr(args.Key),
+	}
+	if args.Value != "" {
+		opt.Value = gitlab.Ptr(args.Value)
 	}
 
 	// Set variable type (default to env_var)
@@ -274,7 +291,11 @@ func createGroupVariable(args GroupVariableArgs) (*mcp.CallToolResult, error) {
 		opt.Description = gitlab.Ptr(args.Description)
 	}
 
-	variable, _, err := util.GitlabClient().GroupVariables.CreateVariable(args.GroupID, opt)
+	if len(args.EnvironmentScopes) > 0 {
+		return createGroupVariableInScopes(ctx, args, opt)
+	}
+
+	variable, _, err := util.GitlabClientFromContext(ctx).GroupVariables.CreateVariable(args.GroupID, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to create group variable: %v", err)), nil
 	}
@@ -295,7 +316,75 @@ func createGroupVariable(args GroupVariableArgs) (*mcp.CallToolResult, error) {
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func updateGroupVariable(args GroupVariableArgs) (*mcp.CallToolResult, error) {
+// createGroupVariableInScopes creates the same key once per environment scope,
+// sharing every option except the scope and, optionally, the value.
+func createGroupVariableInScopes(ctx context.Context, args GroupVariableArgs, opt *gitlab.CreateGroupVariableOptions) (*mcp.CallToolResult, error) {
+	if err := checkScopeValues(args.EnvironmentScopes, args.ScopeValues); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Creating variable '%s' in group %s for %d environment scopes:\n\n", args.Key, args.GroupID, len(args.EnvironmentScopes)))
+
+	failed := 0
+	for _, scope := range args.EnvironmentScopes {
+		scopeOpt := *opt
+		scopeOpt.EnvironmentScope = gitlab.Ptr(scope)
+		if value, ok := args.ScopeValues[scope]; ok {
+			scopeOpt.Value = gitlab.Ptr(value)
+		}
+
+		variable, _, err := util.GitlabClientFromContext(ctx).GroupVariables.CreateVariable(args.GroupID, &scopeOpt, gitlab.WithContext(ctx))
+		if err != nil {
+			failed++
+			result.WriteString(fmt.Sprintf("❌ %s: %v\n", scope, err))
+			continue
+		}
+		result.WriteString(fmt.Sprintf("✅ %s: created (Protected: %t, Masked: %t)\n", variable.EnvironmentScope, variable.Protected, variable.Masked))
+	}
+
+	result.WriteString(fmt.Sprintf("\nCreated: %d, Failed: %d\n", len(args.EnvironmentScopes)-failed, failed))
+
+	switch {
+	case failed == len(args.EnvironmentScopes):
+		return mcp.NewToolResultError(result.String()), nil
+	case failed > 0:
+		result.WriteString("⚠️ Partial failure: the variable is missing in the failed scopes above\n")
+	}
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+// coversEveryScope reports whether scope_values sets a value for each of the
+// environment_scopes, so no shared value is needed
+func coversEveryScope(scopes []string, values map[string]string) bool {
+	if len(scopes) == 0 {
+		return false
+	}
+	for _, scope := range scopes {
+		if _, ok := values[scope]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// checkScopeValues rejects scope_values keys that aren't one of the
+// environment_scopes, which would otherwise be silently ignored
+func checkScopeValues(scopes []string, values map[string]string) error {
+	var unknown []string
+	for scope := range values {
+		if !slices.Contains(scopes, scope) {
+			unknown = append(unknown, scope)
+		}
+	}
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		return fmt.Errorf("scope_values has scopes not listed in environment_scopes: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+func updateGroupVariable(ctx context.Context, args GroupVariableArgs) (*mcp.CallToolResult, error) {
 	if args.Key == "" {
 		return mcp.NewToolResultError("key is required for update action"), nil
 	}
@@ -329,7 +418,7 @@ func updateGroupVariable(args GroupVariableArgs) (*mcp.CallToolResult, error) {
 		opt.Description = gitlab.Ptr(args.Description)
 	}
 
-	variable, _, err := util.GitlabClient().GroupVariables.UpdateVariable(args.GroupID, args.Key, opt)
+	variable, _, err := util.GitlabClientFromContext(ctx).GroupVariables.UpdateVariable(args.GroupID, args.Key, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to update group variable: %v", err)), nil
 	}
@@ -350,12 +439,12 @@ func updateGroupVariable(args GroupVariableArgs) (*mcp.CallToolResult, error) {
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func removeGroupVariable(args GroupVariableArgs) (*mcp.CallToolResult, error) {
+func removeGroupVariable(ctx context.Context, args GroupVariableArgs) (*mcp.CallToolResult, error) {
 	if args.Key == "" {
 		return mcp.NewToolResultError("key is required for remove action"), nil
 	}
 
-	_, err := util.GitlabClient().GroupVariables.RemoveVariable(args.GroupID, args.Key, nil)
+	_, err := util.GitlabClientFromContext(ctx).GroupVariables.RemoveVariable(args.GroupID, args.Key, nil, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to remove group variable: %v", err)), nil
 	}
@@ -367,33 +456,33 @@ func removeGroupVariable(args GroupVariableArgs) (*mcp.CallToolResult, error) {
 func projectVariableHandler(ctx context.Context, request mcp.CallToolRequest, args ProjectVariableArgs) (*mcp.CallToolResult, error) {
 	switch args.Action {
 	case "list":
-		return listProjectVariables(args)
+		return listProjectVariables(ctx, args)
 	case "get":
-		return getProjectVariable(args)
+		return getProjectVariable(ctx, args)
 	case "create":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating a project variable."), nil
 		}
-		return createProjectVariable(args)
+		return createProjectVariable(ctx, args)
 	case "update":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with updating a project variable."), nil
 		}
-		return updateProjectVariable(args)
+		return updateProjectVariable(ctx, args)
 	case "remove":
 		if !args.Confirmed {
 			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with removing a project variable."), nil
 		}
-		return removeProjectVariable(args)
+		return removeProjectVariable(ctx, args)
 	default:
 		return mcp.NewToolResultError(fmt.Sprintf("invalid action: %s. Valid actions are: list, get, create, update, remove", args.Action)), nil
 	}
 }
 
-func listProjectVariables(args ProjectVariableArgs) (*mcp.CallToolResult, error) {
+func listProjectVariables(ctx context.Context, args ProjectVariableArgs) (*mcp.CallToolResult, error) {
 	opt := &gitlab.ListProjectVariablesOptions{}
 
-	variables, _, err := util.GitlabClient().ProjectVariables.ListVariables(args.ProjectID, opt)
+	variables, _, err := util.GitlabClientFromContext(ctx).ProjectVariables.ListVariables(args.ProjectID, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to list project variables: %v", err)), nil
 	}
@@ -407,7 +496,7 @@ func listProjectVariables(args ProjectVariableArgs) (*mcp.CallToolResult, error)
 	}
 
 	// Get project details to show inheritance information
-	project, _, err := util.GitlabClient().Projects.GetProject(args.ProjectID, nil)
+	project, _, err := util.GitlabClientFromContext(ctx).Projects.GetProject(args.ProjectID, nil, gitlab.WithContext(ctx))
 	if err == nil && project.Namespace != nil {
 		result.WriteString(fmt.Sprintf("📁 Project: %s\n", project.Name))
 		result.WriteString(fmt.Sprintf("🏢 Namespace: %s (ID: %d)\n\n", project.Namespace.Name, project.Namespace.ID))
@@ -437,12 +526,12 @@ func listProjectVariables(args ProjectVariableArgs) (*mcp.CallToolResult, error)
 	}
 
 	// Show inherited variables from all ancestor groups
-	ancestors, ancestorErr := getAncestorGroups(args.ProjectID)
+	ancestors, ancestorErr := getAncestorGroups(ctx, args.ProjectID)
 	if ancestorErr == nil && len(ancestors) > 0 {
 		result.WriteString("🏢 Inherited Variables from Ancestor Groups:\n")
 		
 		for groupLevel, group := range ancestors {
-			groupVariables, _, groupErr := util.GitlabClient().GroupVariables.ListVariables(fmt.Sprintf("%d", group.ID), &gitlab.ListGroupVariablesOptions{})
+			groupVariables, _, groupErr := util.GitlabClientFromContext(ctx).GroupVariables.ListVariables(fmt.Sprintf("%d", group.ID), &gitlab.ListGroupVariablesOptions{}, gitlab.WithContext(ctx))
 			if groupErr == nil && len(groupVariables) > 0 {
 				// Show hierarchy level
 				indentLevel := ""
@@ -471,7 +560,7 @@ func listProjectVariables(args ProjectVariableArgs) (*mcp.CallToolResult, error)
 					// Check higher-level groups (closer to project)
 					if !overridden {
 						for j := groupLevel - 1; j >= 0; j-- {
-							higherGroupVars, _, err := util.GitlabClient().GroupVariables.ListVariables(fmt.Sprintf("%d", ancestors[j].ID), &gitlab.ListGroupVariablesOptions{})
+							higherGroupVars, _, err := util.GitlabClientFromContext(ctx).GroupVariables.ListVariables(fmt.Sprintf("%d", ancestors[j].ID), &gitlab.ListGroupVariablesOptions{}, gitlab.WithContext(ctx))
 							if err == nil {
 								for _, higherVar := range higherGroupVars {
 									if higherVar.Key == groupVar.Key && higherVar.EnvironmentScope == groupVar.EnvironmentScope {
@@ -528,13 +617,13 @@ func listProjectVariables(args ProjectVariableArgs) (*mcp.CallToolResult, error)
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func getProjectVariable(args ProjectVariableArgs) (*mcp.CallToolResult, error) {
+func getProjectVariable(ctx context.Context, args ProjectVariableArgs) (*mcp.CallToolResult, error) {
 	if args.Key == "" {
 		return mcp.NewToolResultError("key is required for get action"), nil
 	}
 
 	// Get the specific project variable
-	variable, _, err := util.GitlabClient().ProjectVariables.GetVariable(args.ProjectID, args.Key, nil)
+	variable, _, err := util.GitlabClientFromContext(ctx).ProjectVariables.GetVariable(args.ProjectID, args.Key, nil, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get project variable: %v", err)), nil
 	}
@@ -543,7 +632,7 @@ func getProjectVariable(args ProjectVariableArgs) (*mcp.CallToolResult, error) {
 	result.WriteString(fmt.Sprintf("Variable details for key '%s' in project %s:\n\n", args.Key, args.ProjectID))
 	
 	// Get project details for inheritance context
-	project, _, projectErr := util.GitlabClient().Projects.GetProject(args.ProjectID, nil)
+	project, _, projectErr := util.GitlabClientFromContext(ctx).Projects.GetProject(args.ProjectID, nil, gitlab.WithContext(ctx))
 	if projectErr == nil && project.Namespace != nil {
 		result.WriteString(fmt.Sprintf("📁 Project: %s\n", project.Name))
 		result.WriteString(fmt.Sprintf("🏢 Namespace: %s (ID: %d)\n\n", project.Namespace.Name, project.Namespace.ID))
@@ -574,7 +663,7 @@ func getProjectVariable(args ProjectVariableArgs) (*mcp.CallToolResult, error) {
 	result.WriteString("🔍 Inheritance Information:\n")
 	result.WriteString("  Source: Project-level variable\n")
 	
-	ancestors, ancestorErr := getAncestorGroups(args.ProjectID)
+	ancestors, ancestorErr := getAncestorGroups(ctx, args.ProjectID)
 	if ancestorErr == nil && len(ancestors) > 0 {
 		result.WriteString(fmt.Sprintf("  Hierarchy: Project → %s", ancestors[0].Name))
 		for i := 1; i < len(ancestors); i++ {
@@ -585,7 +674,7 @@ func getProjectVariable(args ProjectVariableArgs) (*mcp.CallToolResult, error) {
 		// Check for variables with the same key in all ancestor groups
 		foundConflicts := false
 		for groupLevel, group := range ancestors {
-			groupVariable, _, groupErr := util.GitlabClient().GroupVariables.GetVariable(fmt.Sprintf("%d", group.ID), args.Key, nil)
+			groupVariable, _, groupErr := util.GitlabClientFromContext(ctx).GroupVariables.GetVariable(fmt.Sprintf("%d", group.ID), args.Key, nil, gitlab.WithContext(ctx))
 			if groupErr == nil {
 				if !foundConflicts {
 					result.WriteString("  ⚠️  Note: Group variables with the same key exist in ancestor groups.\n")
@@ -629,17 +718,19 @@ func getProjectVariable(args ProjectVariableArgs) (*mcp.CallToolResult, error) {
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func createProjectVariable(args ProjectVariableArgs) (*mcp.CallToolResult, error) {
+func createProjectVariable(ctx context.Context, args ProjectVariableArgs) (*mcp.CallToolResult, error) {
 	if args.Key == "" {
 		return mcp.NewToolResultError("key is required for create action"), nil
 	}
-	if args.Value == "" {
-		return mcp.NewToolResultError("value is required for create action"), nil
+	if args.Value == "" && !coversEveryScope(args.EnvironmentScopes, args.ScopeValues) {
+		return mcp.NewToolResultError("value is required for create action, unless scope_values sets every scope in environment_scopes"), nil
 	}
 
 	opt := &gitlab.CreateProjectVariableOptions{
-		Key:   gitlab.Ptr(args.Key),
-		Value: gitlab.Ptr(args.Value),
+		Key: gitlab.Ptr(args.Key),
+	}
+	if args.Value != "" {
+		opt.Value = gitlab.Ptr(args.Value)
 	}
 
 	// Set variable type (default to env_var)
@@ -668,7 +759,11 @@ func createProjectVariable(args ProjectVariableArgs) (*mcp.CallToolResult, error
 		opt.Description = gitlab.Ptr(args.Description)
 	}
 
-	variable, _, err := util.GitlabClient().ProjectVariables.CreateVariable(args.ProjectID, opt)
+	if len(args.EnvironmentScopes) > 0 {
+		return createProjectVariableInScopes(ctx, args, opt)
+	}
+
+	variable, _, err := util.GitlabClientFromContext(ctx).ProjectVariables.CreateVariable(args.ProjectID, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to create project variable: %v", err)), nil
 	}
@@ -691,7 +786,45 @@ func createProjectVariable(args ProjectVariableArgs) (*mcp.CallToolResult, error
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func updateProjectVariable(args ProjectVariableArgs) (*mcp.CallToolResult, error) {
+// createProjectVariableInScopes creates the same key once per environment scope,
+// sharing every option except the scope and, optionally, the value.
+func createProjectVariableInScopes(ctx context.Context, args ProjectVariableArgs, opt *gitlab.CreateProjectVariableOptions) (*mcp.CallToolResult, error) {
+	if err := checkScopeValues(args.EnvironmentScopes, args.ScopeValues); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Creating variable '%s' in project %s for %d environment scopes:\n\n", args.Key, args.ProjectID, len(args.EnvironmentScopes)))
+
+	failed := 0
+	for _, scope := range args.EnvironmentScopes {
+		scopeOpt := *opt
+		scopeOpt.EnvironmentScope = gitlab.Ptr(scope)
+		if value, ok := args.ScopeValues[scope]; ok {
+			scopeOpt.Value = gitlab.Ptr(value)
+		}
+
+		variable, _, err := util.GitlabClientFromContext(ctx).ProjectVariables.CreateVariable(args.ProjectID, &scopeOpt, gitlab.WithContext(ctx))
+		if err != nil {
+			failed++
+			result.WriteString(fmt.Sprintf("❌ %s: %v\n", scope, err))
+			continue
+		}
+		result.WriteString(fmt.Sprintf("✅ %s: created (Protected: %t, Masked: %t)\n", variable.EnvironmentScope, variable.Protected, variable.Masked))
+	}
+
+	result.WriteString(fmt.Sprintf("\nCreated: %d, Failed: %d\n", len(args.EnvironmentScopes)-failed, failed))
+
+	switch {
+	case failed == len(args.EnvironmentScopes):
+		return mcp.NewToolResultError(result.String()), nil
+	case failed > 0:
+		result.WriteString("⚠️ Partial failure: the variable is missing in the failed scopes above\n")
+	}
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func updateProjectVariable(ctx context.Context, args ProjectVariableArgs) (*mcp.CallToolResult, error) {
 	if args.Key == "" {
 		return mcp.NewToolResultError("key is required for update action"), nil
 	}
@@ -725,7 +858,7 @@ func updateProjectVariable(args ProjectVariableArgs) (*mcp.CallToolResult, error
 		opt.Description = gitlab.Ptr(args.Description)
 	}
 
-	variable, _, err := util.GitlabClient().ProjectVariables.UpdateVariable(args.ProjectID, args.Key, opt)
+	variable, _, err := util.GitlabClientFromContext(ctx).ProjectVariables.UpdateVariable(args.ProjectID, args.Key, opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to update project variable: %v", err)), nil
 	}
@@ -748,12 +881,12 @@ func updateProjectVariable(args ProjectVariableArgs) (*mcp.CallToolResult, error
 	return mcp.NewToolResultText(result.String()), nil
 }
 
-func removeProjectVariable(args ProjectVariableArgs) (*mcp.CallToolResult, error) {
+func removeProjectVariable(ctx context.Context, args ProjectVariableArgs) (*mcp.CallToolResult, error) {
 	if args.Key == "" {
 		return mcp.NewToolResultError("key is required for remove action"), nil
 	}
 
-	_, err := util.GitlabClient().ProjectVariables.RemoveVariable(args.ProjectID, args.Key, nil)
+	_, err := util.GitlabClientFromContext(ctx).ProjectVariables.RemoveVariable(args.ProjectID, args.Key, nil, gitlab.WithContext(ctx))
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to remove project variable: %v", err)), nil
 	}
@@ -0,0 +1,83 @@
+package tools
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCreateProjectVariableInScopesFailsWhenEveryScopeFails(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v4/projects/group%2Fproject/variables", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(t, w, map[string]any{"message": map[string]any{"key": []string{"has already been taken"}}})
+	})
+
+	result, err := createProjectVariable(newTestContext(t, mux), ProjectVariableArgs{
+		Action:            "create",
+		ProjectID:         "group/project",
+		Key:               "TOKEN",
+		Value:             "secret",
+		EnvironmentScopes: []string{"staging", "production"},
+	})
+	if err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	if !result.IsError {
+		t.Fatalf("expected an error result when every scope fails, got:\n%s", toolResultText(result))
+	}
+}
+
+func TestCreateProjectVariableInScopesRejectsUnknownScopeValues(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	result, err := createProjectVariable(newTestContext(t, mux), ProjectVariableArgs{
+		Action:            "create",
+		ProjectID:         "group/project",
+		Key:               "TOKEN",
+		Value:             "secret",
+		EnvironmentScopes: []string{"staging"},
+		ScopeValues:       map[string]string{"prod": "other"},
+	})
+	if err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	if text := toolResultText(result); !result.IsError || !strings.Contains(text, "prod") {
+		t.Fatalf("expected an error naming the unknown scope, got:\n%s", text)
+	}
+}
+
+func TestCreateProjectVariableInScopesWithoutSharedValue(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v4/projects/group%2Fproject/variables", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Value            string `json:"value"`
+			EnvironmentScope string `json:"environment_scope"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+			return
+		}
+		if body.Value != "value-"+body.EnvironmentScope {
+			t.Errorf("scope %s sent value %q", body.EnvironmentScope, body.Value)
+		}
+		writeJSON(t, w, map[string]any{"key": "TOKEN", "environment_scope": body.EnvironmentScope})
+	})
+
+	result, err := createProjectVariable(newTestContext(t, mux), ProjectVariableArgs{
+		Action:            "create",
+		ProjectID:         "group/project",
+		Key:               "TOKEN",
+		EnvironmentScopes: []string{"staging", "production"},
+		ScopeValues:       map[string]string{"staging": "value-staging", "production": "value-production"},
+	})
+	text := resultText(t, result, err)
+
+	if !strings.Contains(text, "Created: 2, Failed: 0") {
+		t.Errorf("both scopes should be created:\n%s", text)
+	}
+}
@@ -0,0 +1,167 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/nguyenvanduocit/gitlab-mcp/util"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Consolidated wiki management arguments with action-based routing
+type WikiManagementArgs struct {
+	Action      string `json:"action" validate:"required,oneof=list get create update delete"`
+	ProjectPath string `json:"project_path" validate:"required,min=1,max=255"`
+	Slug        string `json:"slug,omitempty" validate:"omitempty,min=1,max=255"`
+	Title       string `json:"title,omitempty" validate:"omitempty,min=1,max=255"`
+	Content     string `json:"content,omitempty"`
+	Format      string `json:"format,omitempty" validate:"omitempty,oneof=markdown rdoc asciidoc org"`
+	Confirmed   bool   `json:"confirmed,omitempty"`
+}
+
+func RegisterWikiTools(s *server.MCPServer) {
+	wikiManagementTool := mcp.NewTool("manage_wiki",
+		mcp.WithDescription("Manage project wiki pages: list, get, create, update, delete"),
+		mcp.WithString("action", mcp.Required(), mcp.Description("Action to perform: list, get, create, update, delete")),
+		mcp.WithString("project_path", mcp.Required(), mcp.Description("Project/repo path (1-255 characters)")),
+		mcp.WithString("slug", mcp.Description("Page slug, e.g. 'dir/page-name' (required for: get, update, delete)")),
+		mcp.WithString("title", mcp.Description("Page title (required for create, optional for update)")),
+		mcp.WithString("content", mcp.Description("Page content (required for create, optional for update)")),
+		mcp.WithString("format", mcp.Description("Content format: markdown, rdoc, asciidoc, org (default: markdown)")),
+		mcp.WithBoolean("confirmed", mcp.Description("Confirmation required for create, update and delete actions")),
+	)
+
+	s.AddTool(wikiManagementTool, mcp.NewTypedToolHandler(wikiManagementHandler))
+}
+
+// Consolidated wiki management handler
+func wikiManagementHandler(ctx context.Context, request mcp.CallToolRequest, args WikiManagementArgs) (*mcp.CallToolResult, error) {
+	switch args.Action {
+	case "list":
+		return handleListWikiPages(ctx, args)
+	case "get":
+		if args.Slug == "" {
+			return mcp.NewToolResultError("slug is required for get action"), nil
+		}
+		return handleGetWikiPage(ctx, args)
+	case "create":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with creating the wiki page."), nil
+		}
+		if args.Title == "" || args.Content == "" {
+			return mcp.NewToolResultError("title and content are required for create action"), nil
+		}
+		return handleCreateWikiPage(ctx, args)
+	case "update":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with updating the wiki page."), nil
+		}
+		if args.Slug == "" {
+			return mcp.NewToolResultError("slug is required for update action"), nil
+		}
+		if args.Title == "" && args.Content == "" && args.Format == "" {
+			return mcp.NewToolResultError("at least one of title, content or format is required for update action"), nil
+		}
+		return handleUpdateWikiPage(ctx, args)
+	case "delete":
+		if !args.Confirmed {
+			return mcp.NewToolResultError("This operation requires confirmation. Please set 'confirmed: true' to proceed with deleting the wiki page."), nil
+		}
+		if args.Slug == "" {
+			return mcp.NewToolResultError("slug is required for delete action"), nil
+		}
+		return handleDeleteWikiPage(ctx, args)
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported action: %s. Supported actions: list, get, create, update, delete", args.Action)), nil
+	}
+}
+
+func handleListWikiPages(ctx context.Context, args WikiManagementArgs) (*mcp.CallToolResult, error) {
+	pages, _, err := util.GitlabClientFromContext(ctx).Wikis.ListWikis(args.ProjectPath, &gitlab.ListWikisOptions{
+		WithContent: gitlab.Ptr(false),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list wiki pages: %v", err)), nil
+	}
+
+	if len(pages) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("No wiki pages found in %s", args.ProjectPath)), nil
+	}
+
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Wiki pages in %s (%d):\n\n", args.ProjectPath, len(pages)))
+	for _, page := range pages {
+		result.WriteString(fmt.Sprintf("- %s (slug: %s, format: %s)\n", page.Title, page.Slug, page.Format))
+	}
+
+	return mcp.NewToolResultText(result.String()), nil
+}
+
+func handleGetWikiPage(ctx context.Context, args WikiManagementArgs) (*mcp.CallToolResult, error) {
+	page, _, err := util.GitlabClientFromContext(ctx).Wikis.GetWikiPage(args.ProjectPath, args.Slug, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get wiki page: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(formatWikiPage(page, true)), nil
+}
+
+func handleCreateWikiPage(ctx context.Context, args WikiManagementArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.CreateWikiPageOptions{
+		Title:   gitlab.Ptr(args.Title),
+		Content: gitlab.Ptr(args.Content),
+	}
+	if args.Format != "" {
+		opt.Format = gitlab.Ptr(gitlab.WikiFormatValue(args.Format))
+	}
+
+	page, _, err := util.GitlabClientFromContext(ctx).Wikis.CreateWikiPage(args.ProjectPath, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to create wiki page: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText("✅ Wiki page created\n\n" + formatWikiPage(page, false)), nil
+}
+
+func handleUpdateWikiPage(ctx context.Context, args WikiManagementArgs) (*mcp.CallToolResult, error) {
+	opt := &gitlab.EditWikiPageOptions{}
+	if args.Title != "" {
+		opt.Title = gitlab.Ptr(args.Title)
+	}
+	if args.Content != "" {
+		opt.Content = gitlab.Ptr(args.Content)
+	}
+	if args.Format != "" {
+		opt.Format = gitlab.Ptr(gitlab.WikiFormatValue(args.Format))
+	}
+
+	page, _, err := util.GitlabClientFromContext(ctx).Wikis.EditWikiPage(args.ProjectPath, args.Slug, opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to update wiki page: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText("✅ Wiki page updated\n\n" + formatWikiPage(page, false)), nil
+}
+
+func handleDeleteWikiPage(ctx context.Context, args WikiManagementArgs) (*mcp.CallToolResult, error) {
+	_, err := util.GitlabClientFromContext(ctx).Wikis.DeleteWikiPage(args.ProjectPath, args.Slug, gitlab.WithContext(ctx))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to delete wiki page: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("✅ Wiki page %s deleted from %s", args.Slug, args.ProjectPath)), nil
+}
+
+func formatWikiPage(page *gitlab.Wiki, withContent bool) string {
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Title: %s\n", page.Title))
+	result.WriteString(fmt.Sprintf("Slug: %s\n", page.Slug))
+	result.WriteString(fmt.Sprintf("Format: %s\n", page.Format))
+	if withContent {
+		result.WriteString(fmt.Sprintf("\nContent:\n%s\n", page.Content))
+	}
+	return result.String()
+}
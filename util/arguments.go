@@ -0,0 +1,43 @@
+package util
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// sharedArguments are read by middleware instead of tool handlers, so every
+// tool accepts them without declaring them itself
+var sharedArguments = map[string]any{
+	gitlabTokenArg: map[string]any{
+		"type":        "string",
+		"description": "GitLab token to run this call with instead of the server's GITLAB_TOKEN",
+	},
+	gitlabURLArg: map[string]any{
+		"type":        "string",
+		"description": "GitLab instance to run this call against instead of GITLAB_URL; must be GITLAB_URL or listed in GITLAB_ALLOWED_URLS, and needs gitlab_token when it differs from GITLAB_URL",
+	},
+	maxOutputBytesArg: map[string]any{
+		"type":        "number",
+		"description": "Truncate the text result above this many bytes for this call (overrides GITLAB_MAX_OUTPUT_BYTES, 0 disables truncation)",
+	},
+}
+
+// SharedArgumentsFilter adds the arguments handled by middleware to the input
+// schema of every listed tool, so clients know they can send them.
+func SharedArgumentsFilter(ctx context.Context, tools []mcp.Tool) []mcp.Tool {
+	for i := range tools {
+		// Copy the properties: the listed tools share their maps with the registered ones
+		properties := make(map[string]any, len(tools[i].InputSchema.Properties)+len(sharedArguments))
+		for name, schema := range tools[i].InputSchema.Properties {
+			properties[name] = schema
+		}
+		for name, schema := range sharedArguments {
+			if _, ok := properties[name]; !ok {
+				properties[name] = schema
+			}
+		}
+		tools[i].InputSchema.Properties = properties
+	}
+	return tools
+}
@@ -0,0 +1,61 @@
+package util
+
+import (
+	"container/list"
+	"sync"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// clientCache is a fixed-size LRU cache of GitLab clients
+type clientCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type cachedClient struct {
+	key    string
+	client *gitlab.Client
+}
+
+func newClientCache(size int) *clientCache {
+	return &clientCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (c *clientCache) get(key string) (*gitlab.Client, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*cachedClient).client, true
+}
+
+// add stores client under key and returns the cached client, which is the
+// existing one if another call stored it first
+func (c *clientCache) add(key string, client *gitlab.Client) *gitlab.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.order.MoveToFront(element)
+		return element.Value.(*cachedClient).client
+	}
+
+	c.entries[key] = c.order.PushFront(&cachedClient{key: key, client: client})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedClient).key)
+	}
+	return client
+}
@@ -0,0 +1,74 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// Matches "7d", "2w", "3mo", "1y" as well as "7 days ago", "2 weeks ago"
+var relativeDatePattern = regexp.MustCompile(`^(\d+)\s*(d|days?|w|weeks?|mo|months?|y|years?)(\s+ago)?$`)
+
+// LoadTimezone resolves an IANA timezone name such as "Asia/Ho_Chi_Minh".
+// An empty name means UTC, which is how date inputs were always interpreted.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %v", name, err)
+	}
+	return loc, nil
+}
+
+// ResolveDate converts a date input into YYYY-MM-DD. Besides absolute dates it
+// accepts "today", "yesterday", "last week", "last month" and relative offsets
+// into the past such as "7d", "2w", "3mo", "1y" or "10 days ago". Relative
+// inputs are computed from the current date in loc.
+func ResolveDate(value string, loc *time.Location) (string, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if _, err := time.Parse(dateLayout, value); err == nil {
+		return value, nil
+	}
+
+	today := time.Now().In(loc)
+	switch value {
+	case "today", "now":
+		return today.Format(dateLayout), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1).Format(dateLayout), nil
+	case "last week":
+		return today.AddDate(0, 0, -7).Format(dateLayout), nil
+	case "last month":
+		return today.AddDate(0, -1, 0).Format(dateLayout), nil
+	case "last year":
+		return today.AddDate(-1, 0, 0).Format(dateLayout), nil
+	}
+
+	match := relativeDatePattern.FindStringSubmatch(value)
+	if match == nil {
+		return "", fmt.Errorf("unrecognized date %q: use YYYY-MM-DD, today, yesterday, last week, last month, or an offset like 7d, 2w, 3mo, 1y", value)
+	}
+
+	amount, err := strconv.Atoi(match[1])
+	if err != nil {
+		return "", fmt.Errorf("invalid date offset %q: %v", value, err)
+	}
+
+	switch unit := match[2]; {
+	case strings.HasPrefix(unit, "d"):
+		return today.AddDate(0, 0, -amount).Format(dateLayout), nil
+	case strings.HasPrefix(unit, "w"):
+		return today.AddDate(0, 0, -7*amount).Format(dateLayout), nil
+	case strings.HasPrefix(unit, "mo"):
+		return today.AddDate(0, -amount, 0).Format(dateLayout), nil
+	default:
+		return today.AddDate(-amount, 0, 0).Format(dateLayout), nil
+	}
+}
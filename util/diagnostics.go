@@ -0,0 +1,123 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// verbose enables the diagnostics footer; set once at startup from the --verbose flag
+var verbose bool
+
+// SetVerbose turns API call diagnostics on or off. It must be called before
+// the first GitLab client is created.
+func SetVerbose(enabled bool) {
+	verbose = enabled
+}
+
+// apiCall is one HTTP attempt made to the GitLab API
+type apiCall struct {
+	method  string
+	path    string
+	status  int
+	err     error
+	elapsed time.Duration
+	retry   bool
+}
+
+// callLog collects the API calls made while a single tool call runs
+type callLog struct {
+	mu       sync.Mutex
+	calls    []apiCall
+	attempts map[*http.Request]int
+}
+
+func (l *callLog) record(req *http.Request, call apiCall) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// The retrying client resends the same request, so a repeat means a retry
+	call.retry = l.attempts[req] > 0
+	l.attempts[req]++
+	l.calls = append(l.calls, call)
+}
+
+type callLogKey struct{}
+
+// diagnosticsTransport records every request it sends in the matching call logs
+type diagnosticsTransport struct {
+	base http.RoundTripper
+}
+
+func (t *diagnosticsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+
+	call := apiCall{
+		method:  req.Method,
+		path:    req.URL.Path,
+		err:     err,
+		elapsed: time.Since(start),
+	}
+	if resp != nil {
+		call.status = resp.StatusCode
+	}
+
+	// Only the tool call that made the request sees it, so concurrent callers
+	// never see each other's traces
+	if log, ok := req.Context().Value(callLogKey{}).(*callLog); ok {
+		log.record(req, call)
+	}
+
+	return resp, err
+}
+
+// DiagnosticsMiddleware appends the GitLab endpoints called, their status,
+// timing and retries to each tool result when verbose mode is on.
+func DiagnosticsMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if !verbose {
+			return next(ctx, request)
+		}
+
+		log := &callLog{attempts: make(map[*http.Request]int)}
+		start := time.Now()
+
+		result, err := next(context.WithValue(ctx, callLogKey{}, log), request)
+		if err != nil || result == nil {
+			return result, err
+		}
+
+		result.Content = append(result.Content, mcp.NewTextContent(log.footer(time.Since(start))))
+		return result, nil
+	}
+}
+
+func (l *callLog) footer(total time.Duration) string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var footer strings.Builder
+	retries := 0
+	footer.WriteString("--- diagnostics ---\n")
+	for _, call := range l.calls {
+		status := fmt.Sprintf("%d", call.status)
+		if call.err != nil {
+			status = fmt.Sprintf("error: %v", call.err)
+		}
+		footer.WriteString(fmt.Sprintf("%s %s → %s (%s)", call.method, call.path, status, call.elapsed.Round(time.Millisecond)))
+		if call.retry {
+			retries++
+			footer.WriteString(" [retry]")
+		}
+		footer.WriteString("\n")
+	}
+	footer.WriteString(fmt.Sprintf("API requests: %d, retries: %d, total time: %s\n", len(l.calls), retries, total.Round(time.Millisecond)))
+	return footer.String()
+}
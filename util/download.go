@@ -0,0 +1,42 @@
+package util
+
+import (
+	"bytes"
+	"errors"
+)
+
+// ErrLimitExceeded is returned by LimitedBuffer writes past its limit
+var ErrLimitExceeded = errors.New("size limit exceeded")
+
+// LimitedBuffer keeps at most Limit bytes of a download. Writes past the limit
+// fail with ErrLimitExceeded and call OnLimit, which should cancel the
+// request's context: the GitLab client drains the rest of the body otherwise.
+type LimitedBuffer struct {
+	Limit   int64
+	OnLimit func()
+	buf     bytes.Buffer
+}
+
+// Write stores what still fits and fails with ErrLimitExceeded for the rest
+func (b *LimitedBuffer) Write(p []byte) (int, error) {
+	room := b.Limit - int64(b.buf.Len())
+	if int64(len(p)) <= room {
+		return b.buf.Write(p)
+	}
+
+	n, _ := b.buf.Write(p[:max(room, 0)])
+	if b.OnLimit != nil {
+		b.OnLimit()
+	}
+	return n, ErrLimitExceeded
+}
+
+// Bytes returns the bytes kept so far
+func (b *LimitedBuffer) Bytes() []byte {
+	return b.buf.Bytes()
+}
+
+// Len returns the number of bytes kept so far
+func (b *LimitedBuffer) Len() int {
+	return b.buf.Len()
+}
@@ -0,0 +1,71 @@
+package util
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// errorHint pairs a fragment of a GitLab error message with a suggested next step
+type errorHint struct {
+	match string
+	hint  string
+}
+
+// Matched case-insensitively against error results, first match wins
+var errorHints = []errorHint{
+	{"branch already exists", "The branch is already there. Reuse it, pick a different name, or delete it first with manage_branches."},
+	{"tag already exists", "The tag is already there. Choose a new tag name or delete the existing tag with manage_tags."},
+	{"sha does not match", "The MR head changed since it was read. Fetch the merge request again and retry with the new head SHA."},
+	{"can't be merged", "Check detailed merge status with can_merge: the MR may have conflicts, a failing pipeline, unresolved discussions or missing approvals."},
+	{"cannot be merged", "Check detailed merge status with can_merge: the MR may have conflicts, a failing pipeline, unresolved discussions or missing approvals."},
+	{"405 method not allowed", "GitLab refused the action in the MR's current state. Check that it is open, not a draft, has no conflicts and meets the project's merge checks."},
+	{"406 not acceptable", "The branch has conflicts or can't be rebased automatically. Resolve conflicts locally or rebase the source branch first."},
+	{"409 conflict", "The resource already exists or was changed concurrently. Fetch the current state and retry."},
+	{"another open merge request already exists", "An open MR already uses this source branch. Update that MR instead of creating a new one."},
+	{"401 unauthorized", "The token was rejected. Check that GITLAB_TOKEN is valid and not expired."},
+	{"403 forbidden", "The token lacks permission for this action. It may need a higher role on the project or the api scope."},
+	{"404 not found", "The project, ref or object wasn't found, or the token can't see it. Check the path (group/project), IDs and branch names."},
+	{"you are not allowed to push", "The branch is protected. Push to a new branch and open a merge request, or check manage_branch_protection."},
+	{"file with this name already exists", "Use the update action instead of create to change an existing file."},
+	{"file with this name doesn't exist", "Use the create action instead of update, or check the file path and branch."},
+	{"invalid reference name", "The branch or tag name is not a valid git ref. Avoid spaces, '..', '~', '^', ':' and trailing slashes."},
+	{"429 too many requests", "GitLab is rate limiting requests. Wait a moment before retrying and narrow the request."},
+}
+
+// ErrorHintMiddleware appends a suggested remediation to error results that
+// match a known GitLab failure, so the raw API message isn't misread.
+func ErrorHintMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := next(ctx, request)
+		if err != nil || result == nil || !result.IsError {
+			return result, err
+		}
+
+		for i, content := range result.Content {
+			text, ok := content.(mcp.TextContent)
+			if !ok {
+				continue
+			}
+			if hint := ErrorHint(text.Text); hint != "" {
+				text.Text += "\n\nSuggestion: " + hint
+				result.Content[i] = text
+			}
+		}
+		return result, nil
+	}
+}
+
+// ErrorHint returns the suggested next step for a GitLab error message, or ""
+// when the error isn't one of the known cases.
+func ErrorHint(message string) string {
+	message = strings.ToLower(message)
+	for _, h := range errorHints {
+		if strings.Contains(message, h.match) {
+			return h.hint
+		}
+	}
+	return ""
+}
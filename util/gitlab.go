@@ -1,8 +1,12 @@
 package util
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"strings"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -20,10 +24,65 @@ var GitlabClient = sync.OnceValue[*gitlab.Client](func() *gitlab.Client {
 		log.Fatal("GITLAB_URL is required")
 	}
 
-	client, err := gitlab.NewClient(token, gitlab.WithBaseURL(host))
+	client, err := gitlab.NewClient(token, clientOptions(host)...)
 	if err != nil {
 		log.Fatal(errors.WithMessage(err, "failed to create gitlab client"))
 	}
 
 	return client
-})
\ No newline at end of file
+})
+
+// clientOptions returns the options shared by every GitLab client
+func clientOptions(host string) []gitlab.ClientOptionFunc {
+	options := append([]gitlab.ClientOptionFunc{gitlab.WithBaseURL(host)}, retryOptions()...)
+	return append(options, gitlab.WithHTTPClient(httpClient()))
+}
+
+// httpClient returns the HTTP client GitLab clients send requests through.
+// Rate limit headers are always read; requests are logged in verbose mode.
+func httpClient() *http.Client {
+	var transport http.RoundTripper = &rateLimitTransport{base: http.DefaultTransport}
+	if verbose {
+		transport = &diagnosticsTransport{base: transport}
+	}
+	return &http.Client{Transport: transport}
+}
+
+// identityClients caches clients for alternate identities, keyed by instance URL and normalized identity name
+var identityClients sync.Map
+
+// GitlabClientFor returns a client authenticated as the named identity. The token is read
+// from GITLAB_TOKEN_<NAME>, e.g. identity "review-bot" uses GITLAB_TOKEN_REVIEW_BOT.
+// An empty identity returns the client for the caller's own token and instance.
+func GitlabClientFor(ctx context.Context, identity string) (*gitlab.Client, error) {
+	if identity == "" {
+		return GitlabClientFromContext(ctx), nil
+	}
+
+	// Identity tokens are issued by GITLAB_URL, so never send them anywhere else
+	baseURL, _ := ctx.Value(urlKey{}).(string)
+	if baseURL == "" {
+		baseURL = os.Getenv("GITLAB_URL")
+	} else if !sameInstance(baseURL, os.Getenv("GITLAB_URL")) {
+		return nil, fmt.Errorf("identity %q is only available on GITLAB_URL, not %s", identity, baseURL)
+	}
+
+	name := strings.ToUpper(strings.ReplaceAll(identity, "-", "_"))
+	key := baseURL + "\x00" + name
+	if client, ok := identityClients.Load(key); ok {
+		return client.(*gitlab.Client), nil
+	}
+
+	token := os.Getenv("GITLAB_TOKEN_" + name)
+	if token == "" {
+		return nil, fmt.Errorf("no token configured for identity %q, set GITLAB_TOKEN_%s", identity, name)
+	}
+
+	client, err := gitlab.NewClient(token, clientOptions(baseURL)...)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to create gitlab client")
+	}
+
+	actual, _ := identityClients.LoadOrStore(key, client)
+	return actual.(*gitlab.Client), nil
+}
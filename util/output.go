@@ -0,0 +1,71 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxOutputBytesArg is accepted by every tool to override the output limit per call
+const maxOutputBytesArg = "max_output_bytes"
+
+var envMaxOutputBytes = sync.OnceValue[int](func() int {
+	value := os.Getenv("GITLAB_MAX_OUTPUT_BYTES")
+	if value == "" {
+		return 0
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 0 {
+		log.Printf("ignoring invalid GITLAB_MAX_OUTPUT_BYTES %q", value)
+		return 0
+	}
+	return limit
+})
+
+// OutputLimitMiddleware truncates text results larger than the configured limit.
+// The limit comes from the max_output_bytes argument when a call provides it,
+// otherwise from GITLAB_MAX_OUTPUT_BYTES; zero means no limit.
+func OutputLimitMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := next(ctx, request)
+		if err != nil || result == nil {
+			return result, err
+		}
+
+		limit := envMaxOutputBytes()
+		if _, ok := request.GetArguments()[maxOutputBytesArg]; ok {
+			limit = request.GetInt(maxOutputBytesArg, limit)
+		}
+		if limit <= 0 {
+			return result, nil
+		}
+
+		for i, content := range result.Content {
+			text, ok := content.(mcp.TextContent)
+			if !ok || len(text.Text) <= limit {
+				continue
+			}
+			text.Text = truncateText(text.Text, limit)
+			result.Content[i] = text
+		}
+		return result, nil
+	}
+}
+
+// truncateText cuts text to at most limit bytes without splitting a UTF-8
+// sequence and appends a marker saying how much was dropped.
+func truncateText(text string, limit int) string {
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	return text[:cut] + fmt.Sprintf("\n\n... [output truncated: showing %d of %d bytes, narrow the request or raise %s]", cut, len(text), maxOutputBytesArg)
+}
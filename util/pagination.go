@@ -0,0 +1,76 @@
+package util
+
+import (
+	"log"
+	"math"
+	"os"
+	"strconv"
+	"sync"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Page size limits accepted by the GitLab API
+const (
+	minPerPage = 1
+	maxPerPage = 100
+)
+
+var envPerPage = sync.OnceValue[int](func() int {
+	value := os.Getenv("GITLAB_DEFAULT_PER_PAGE")
+	if value == "" {
+		return 0
+	}
+
+	perPage, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("ignoring invalid GITLAB_DEFAULT_PER_PAGE %q: %v", value, err)
+		return 0
+	}
+
+	if perPage < minPerPage {
+		return minPerPage
+	}
+	if perPage > maxPerPage {
+		return maxPerPage
+	}
+	return perPage
+})
+
+// DefaultPerPage returns the page size for list requests. GITLAB_DEFAULT_PER_PAGE
+// takes precedence when set, clamped to 1-100; otherwise fallback is used.
+func DefaultPerPage(fallback int) int {
+	if perPage := envPerPage(); perPage > 0 {
+		return perPage
+	}
+	return fallback
+}
+
+// CollectPages calls list for successive pages until GitLab reports no next
+// page or limit items were gathered. opt must be the ListOptions that list
+// sends, so the page can be advanced. truncated reports whether items past
+// limit exist and were dropped.
+func CollectPages[T any](opt *gitlab.ListOptions, limit int, list func() ([]T, *gitlab.Response, error)) (items []T, truncated bool, err error) {
+	for {
+		page, resp, err := list()
+		if err != nil {
+			return nil, false, err
+		}
+		items = append(items, page...)
+
+		// Check the cap before following the next page: the last page can overshoot it too
+		if len(items) >= limit {
+			return items[:limit], len(items) > limit || resp.NextPage != 0, nil
+		}
+		if resp.NextPage == 0 {
+			return items, false, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// AllPages is CollectPages without a cap, for lists that stay small such as a pipeline's jobs
+func AllPages[T any](opt *gitlab.ListOptions, list func() ([]T, *gitlab.Response, error)) ([]T, error) {
+	items, _, err := CollectPages(opt, math.MaxInt, list)
+	return items, err
+}
@@ -0,0 +1,102 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Warn once fewer than this share of the rate limit is left
+const rateLimitWarnRatio = 0.1
+
+// rateLimit tracks the lowest RateLimit-Remaining GitLab reported during a tool call
+type rateLimit struct {
+	mu        sync.Mutex
+	seen      bool
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+func (r *rateLimit) record(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	limit, _ := strconv.Atoi(header.Get("RateLimit-Limit"))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.seen && remaining >= r.remaining {
+		return
+	}
+	r.seen = true
+	r.limit = limit
+	r.remaining = remaining
+	if reset, err := strconv.ParseInt(header.Get("RateLimit-Reset"), 10, 64); err == nil {
+		r.reset = time.Unix(reset, 0)
+	}
+}
+
+// warning returns a note for the tool result when the limit is nearly used up, or ""
+func (r *rateLimit) warning() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.seen || r.limit <= 0 || float64(r.remaining) >= float64(r.limit)*rateLimitWarnRatio {
+		return ""
+	}
+
+	warning := fmt.Sprintf("⚠️ GitLab rate limit nearly exhausted: %d of %d requests left", r.remaining, r.limit)
+	if !r.reset.IsZero() {
+		warning += fmt.Sprintf(", resets at %s", r.reset.Format("15:04:05"))
+	}
+	return warning + ". Slow down or batch fewer calls to avoid failures."
+}
+
+type rateLimitKey struct{}
+
+// rateLimitTransport reads the rate limit headers of every response
+type rateLimitTransport struct {
+	base http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if resp == nil {
+		return resp, err
+	}
+
+	// Only the tool call that made the request is warned; another caller's
+	// token has its own limit
+	if tracker, ok := req.Context().Value(rateLimitKey{}).(*rateLimit); ok {
+		tracker.record(resp.Header)
+	}
+
+	return resp, err
+}
+
+// RateLimitMiddleware appends a warning to tool results when GitLab reports
+// that few requests are left in the current rate limit window.
+func RateLimitMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		tracker := &rateLimit{}
+
+		result, err := next(context.WithValue(ctx, rateLimitKey{}, tracker), request)
+		if err != nil || result == nil {
+			return result, err
+		}
+
+		if warning := tracker.warning(); warning != "" {
+			result.Content = append(result.Content, mcp.NewTextContent(warning))
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,152 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/pkg/errors"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+const (
+	// gitlabTokenArg is accepted by every tool to act with a different token for one call
+	gitlabTokenArg = "gitlab_token"
+	// gitlabURLArg is accepted by every tool to target a different GitLab instance for one call
+	gitlabURLArg = "gitlab_url"
+)
+
+type tokenKey struct{}
+
+type urlKey struct{}
+
+// maxScopedClients bounds the cache of per-request clients; the least recently
+// used client is dropped once it is full
+const maxScopedClients = 64
+
+// scopedClients caches clients for instances and tokens supplied per request
+var scopedClients = newClientCache(maxScopedClients)
+
+// allowedBaseURLs lists the instances a request may target: GITLAB_URL plus the
+// comma-separated GITLAB_ALLOWED_URLS
+var allowedBaseURLs = sync.OnceValue[[]string](func() []string {
+	allowed := []string{os.Getenv("GITLAB_URL")}
+	for _, baseURL := range strings.Split(os.Getenv("GITLAB_ALLOWED_URLS"), ",") {
+		if baseURL = strings.TrimSpace(baseURL); baseURL != "" {
+			allowed = append(allowed, baseURL)
+		}
+	}
+	return allowed
+})
+
+// WithToken returns a context whose GitLab calls use token instead of GITLAB_TOKEN
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+// WithBaseURL returns a context whose GitLab calls go to baseURL instead of GITLAB_URL
+func WithBaseURL(ctx context.Context, baseURL string) context.Context {
+	return context.WithValue(ctx, urlKey{}, baseURL)
+}
+
+// ValidateBaseURL checks that a GitLab instance URL is an absolute http(s) URL
+// and one the server is configured to reach, so callers can't point it at
+// arbitrary hosts
+func ValidateBaseURL(baseURL string) error {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return fmt.Errorf("invalid GitLab URL %q: %v", baseURL, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("invalid GitLab URL %q: scheme must be http or https", baseURL)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("invalid GitLab URL %q: missing host", baseURL)
+	}
+	for _, allowed := range allowedBaseURLs() {
+		if sameInstance(baseURL, allowed) {
+			return nil
+		}
+	}
+	return fmt.Errorf("GitLab URL %q is not allowed, add it to GITLAB_ALLOWED_URLS", baseURL)
+}
+
+// GitlabClientFromContext returns a client for the instance and token carried by
+// ctx, or the default client when the request did not supply either.
+func GitlabClientFromContext(ctx context.Context) *gitlab.Client {
+	client, err := scopedClient(ctx)
+	if err != nil {
+		// TokenMiddleware validates the scope up front, so this only happens
+		// for contexts built outside a tool call
+		return GitlabClient()
+	}
+	return client
+}
+
+// sameInstance reports whether two GitLab base URLs point at the same instance
+func sameInstance(a, b string) bool {
+	return strings.TrimRight(a, "/") == strings.TrimRight(b, "/")
+}
+
+func scopedClient(ctx context.Context) (*gitlab.Client, error) {
+	token, _ := ctx.Value(tokenKey{}).(string)
+	baseURL, _ := ctx.Value(urlKey{}).(string)
+	if token == "" && baseURL == "" {
+		return GitlabClient(), nil
+	}
+
+	defaultURL := os.Getenv("GITLAB_URL")
+	if baseURL == "" {
+		baseURL = defaultURL
+	}
+	if token == "" {
+		// Never send the server token to an instance it was not issued for
+		if !sameInstance(baseURL, defaultURL) {
+			return nil, fmt.Errorf("gitlab_url %q differs from GITLAB_URL, supply a gitlab_token for it", baseURL)
+		}
+		token = os.Getenv("GITLAB_TOKEN")
+	}
+
+	key := baseURL + "\x00" + token
+	if client, ok := scopedClients.get(key); ok {
+		return client, nil
+	}
+
+	client, err := gitlab.NewClient(token, clientOptions(baseURL)...)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to create gitlab client")
+	}
+
+	return scopedClients.add(key, client), nil
+}
+
+// TokenMiddleware scopes a tool call to the instance and token given in its
+// gitlab_url and gitlab_token arguments, so tools can act on behalf of the
+// caller or against another GitLab instance.
+func TokenMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if token := request.GetString(gitlabTokenArg, ""); token != "" {
+			ctx = WithToken(ctx, token)
+		}
+		if baseURL := request.GetString(gitlabURLArg, ""); baseURL != "" {
+			ctx = WithBaseURL(ctx, baseURL)
+		}
+
+		// The URL may also come from an HTTP header, so validate whatever ended up in ctx
+		if baseURL, _ := ctx.Value(urlKey{}).(string); baseURL != "" {
+			if err := ValidateBaseURL(baseURL); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+
+		if _, err := scopedClient(ctx); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		return next(ctx, request)
+	}
+}
@@ -0,0 +1,26 @@
+package util
+
+import (
+	"fmt"
+	"net/http"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ResponseError describes a failed GitLab call using the response status, so
+// a missing resource and a missing permission read differently. what names the
+// object that was requested, e.g. "merge request !12 in group/project".
+// Statuses without a specific message fall back to the raw error.
+func ResponseError(action, what string, resp *gitlab.Response, err error) string {
+	if resp != nil {
+		switch resp.StatusCode {
+		case http.StatusNotFound:
+			return fmt.Sprintf("failed to %s: %s not found. Check the path and ID, or whether the token can see it", action, what)
+		case http.StatusForbidden:
+			return fmt.Sprintf("failed to %s: insufficient permissions to access %s. The token needs a higher role or the api scope", action, what)
+		case http.StatusUnauthorized:
+			return fmt.Sprintf("failed to %s: the token was rejected. Check that it is valid and not expired", action)
+		}
+	}
+	return fmt.Sprintf("failed to %s: %v", action, err)
+}
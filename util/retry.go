@@ -0,0 +1,56 @@
+package util
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/go-retryablehttp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+const defaultMaxRetries = 3
+
+var envMaxRetries = sync.OnceValue[int](func() int {
+	value := os.Getenv("GITLAB_MAX_RETRIES")
+	if value == "" {
+		return defaultMaxRetries
+	}
+
+	retries, err := strconv.Atoi(value)
+	if err != nil || retries < 0 {
+		log.Printf("ignoring invalid GITLAB_MAX_RETRIES %q", value)
+		return defaultMaxRetries
+	}
+	return retries
+})
+
+// retryOptions make every client retry transient failures with exponential
+// backoff. DefaultBackoff waits for the Retry-After header on 429 and 503.
+func retryOptions() []gitlab.ClientOptionFunc {
+	return []gitlab.ClientOptionFunc{
+		gitlab.WithCustomRetry(retryTransient),
+		gitlab.WithCustomRetryMax(envMaxRetries()),
+		gitlab.WithCustomBackoff(retryablehttp.DefaultBackoff),
+	}
+}
+
+// retryTransient retries rate limiting and gateway errors only; other 5xx
+// responses usually mean the request itself is bad and would fail again.
+func retryTransient(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+	if err != nil {
+		return false, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true, nil
+	}
+	return false, nil
+}